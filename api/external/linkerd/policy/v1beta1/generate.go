@@ -0,0 +1,3 @@
+package v1beta1
+
+//go:generate ./generate.sh
@@ -0,0 +1,23 @@
+#!/usr/bin/env bash
+
+set -ex
+
+ROOT=${GOPATH}/src
+SUPERGLOO=${ROOT}/github.com/solo-io/supergloo
+IN=${SUPERGLOO}/api/external/linkerd/v1alpha1/
+OUT=${SUPERGLOO}/pkg/api/external/linkerd/v1alpha1/
+
+IMPORTS="\
+    -I=${IN} \
+    -I=${SUPERGLOO}/api/external \
+    -I=${ROOT}/github.com/solo-io/solo-kit/api/external \
+    -I=${ROOT} \
+    "
+
+GOGO_FLAG="--gogo_out=Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types:${GOPATH}/src/"
+INPUT_PROTOS="${IN}/*.proto"
+
+mkdir -p ${OUT}
+protoc ${IMPORTS} \
+    ${GOGO_FLAG} \
+    ${INPUT_PROTOS}
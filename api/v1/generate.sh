@@ -6,19 +6,22 @@ ROOT=${GOPATH}/src
 SUPERGLOO=${ROOT}/github.com/solo-io/supergloo
 GLOO_IN=${SUPERGLOO}/api/external/gloo/v1/
 ISTIO_ENCRYPTION_IN=${SUPERGLOO}/api/external/istio/encryption/v1/
+# destination_rule.proto imports virtual_service.proto relative to its own directory
+ISTIO_NETWORKING_IN=${SUPERGLOO}/api/external/istio/networking/v1alpha3/
 
 IN=${SUPERGLOO}/api/v1/
 OUT=${SUPERGLOO}/pkg/api/v1/
 
 IMPORTS="\
     -I=${ISTIO_ENCRYPTION_IN} \
+    -I=${ISTIO_NETWORKING_IN} \
     -I=${GLOO_IN} \
     -I=${IN} \
     -I=${SUPERGLOO}/api/external \
     -I=${ROOT}/github.com/solo-io/solo-kit/api/external \
     -I=${ROOT}"
 
-GOGO_FLAG="--gogo_out=Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types:${GOPATH}/src/"
+GOGO_FLAG="--gogo_out=Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types:${GOPATH}/src/"
 
 mkdir -p ${OUT}
 
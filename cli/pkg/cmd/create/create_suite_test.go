@@ -0,0 +1,13 @@
+package create_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCreate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Create Test")
+}
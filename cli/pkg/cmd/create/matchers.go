@@ -0,0 +1,150 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	glooV1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+)
+
+const matcherSyntax = `A matcher is a comma-separated list of clauses, all of which a request must match:
+  prefix=PATH, exact=PATH or regex=PATH_REGEX   the path of the request (at most one of them)
+  header:NAME=VALUE                             a header with the value
+  header:NAME~=REGEX                            a header whose value matches the regex
+  header:NAME                                   a header with any value
+  query:NAME=VALUE, query:NAME~=REGEX, query:NAME   the same for a query parameter
+  method=METHOD[|METHOD...]                     one of the http methods
+e.g. "prefix=/api,header:x-user=beta,method=GET|POST"`
+
+// returns the matchers given by --match, or prompts for them in interactive mode
+func ensureMatchers(opts *options.Options) ([]*glooV1.Matcher, error) {
+	rrOpts := &(opts.Create).RoutingRule
+	// the flags are bound to separate slices, as each replaces its slice when first given
+	rrOpts.Matchers = append(rrOpts.Matchers, rrOpts.DeprecatedMatchers...)
+	rrOpts.DeprecatedMatchers = nil
+	if len(rrOpts.Matchers) == 0 && !opts.Top.Static {
+		question := "Do you want to match the requests of this rule (otherwise it applies to all of them)?"
+		for {
+			add, err := common.ChooseBool(question)
+			if err != nil {
+				return nil, err
+			}
+			if !add {
+				break
+			}
+			matcher, err := common.ChooseString("Matcher (e.g. prefix=/api,header:x-user=beta,method=GET)", matcherSyntax,
+				func(matcher string) error {
+					_, err := parseMatcher(matcher)
+					return err
+				})
+			if err != nil {
+				return nil, err
+			}
+			rrOpts.Matchers = append(rrOpts.Matchers, matcher)
+			question = "Do you want to add another matcher?"
+		}
+	}
+	var matchers []*glooV1.Matcher
+	for _, matcher := range rrOpts.Matchers {
+		m, err := parseMatcher(matcher)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+	return matchers, nil
+}
+
+// Each matcher is one occurrence of the --match flag and results in one glooV1.Matcher
+// e.g. matcher = "prefix=/some/path,header:x-user=beta,method=get|post"
+func parseMatcher(matcher string) (*glooV1.Matcher, error) {
+	m := &glooV1.Matcher{}
+
+	// 'clauses' is an array of matcher clauses
+	// e.g. clauses = { "prefix=/some/path", "header:x-user=beta", "method=get|post" }
+	for _, clause := range strings.Split(matcher, common.ListOptionSeparator) {
+		invalidClause := errors.Errorf(common.InvalidOptionFormat, clause, "create routingrule")
+
+		// headers and query parameters are matched by name, the value is optional
+		if kind := strings.SplitN(clause, ":", 2); len(kind) == 2 && (kind[0] == "header" || kind[0] == "query") {
+			name, value, regex := splitNameValue(kind[1])
+			if name == "" {
+				return nil, invalidClause
+			}
+			if kind[0] == "header" {
+				m.Headers = append(m.Headers, &glooV1.HeaderMatcher{Name: name, Value: value, Regex: regex})
+			} else {
+				m.QueryParameters = append(m.QueryParameters, &glooV1.QueryParameterMatcher{Name: name, Value: value, Regex: regex})
+			}
+			continue
+		}
+
+		// 'parts' are the two parts of the clause, separated by the "=" character
+		// e.g. parts = { "prefix", "/some/path"}
+		parts := strings.SplitN(clause, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, invalidClause
+		}
+		matcherType, value := parts[0], parts[1]
+		switch matcherType {
+		case "prefix", "exact", "regex":
+			// We can have only one path specifier per matcher
+			if m.PathSpecifier != nil {
+				return nil, invalidClause
+			}
+			switch matcherType {
+			case "prefix":
+				m.PathSpecifier = &glooV1.Matcher_Prefix{Prefix: value}
+			case "exact":
+				m.PathSpecifier = &glooV1.Matcher_Exact{Exact: value}
+			case "regex":
+				m.PathSpecifier = &glooV1.Matcher_Regex{Regex: value}
+			}
+
+		case "method", "methods":
+			// If the user specified more than one "method" clause, return error. We could just merge the two
+			// clauses, but this scenario is most likely an error we want the user to be aware of.
+			if m.Methods != nil {
+				return nil, invalidClause
+			}
+			methods, err := validateMethods(value)
+			if err != nil {
+				return nil, err
+			}
+			m.Methods = methods
+
+		default:
+			return nil, invalidClause
+		}
+	}
+
+	return m, nil
+}
+
+// splits "name=value" or "name~=regex" into its parts, the value is empty for "name"
+func splitNameValue(clause string) (string, string, bool) {
+	if i := strings.Index(clause, "~="); i >= 0 {
+		return clause[:i], clause[i+2:], true
+	}
+	if i := strings.Index(clause, "="); i >= 0 {
+		return clause[:i], clause[i+1:], false
+	}
+	return clause, "", false
+}
+
+// validates http methods separated by "|", they are returned in upper case
+func validateMethods(value string) ([]string, error) {
+	validMethods := strings.Split(common.ValidMatcherHttpMethods, common.SubListOptionSeparator)
+	var methods []string
+	for _, method := range strings.Split(value, common.SubListOptionSeparator) {
+		method = strings.ToUpper(method)
+		if !common.Contains(validMethods, method) {
+			return nil, fmt.Errorf(common.InvalidMatcherHttpMethod, method)
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
@@ -2,11 +2,13 @@ package create
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox/routerule"
 	"github.com/solo-io/supergloo/cli/pkg/common"
-
-	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -60,10 +62,39 @@ func RoutingRuleCmd(opts *options.Options) *cobra.Command {
 		false,
 		"If set to \"true\", the command will override any existing routing rule that matches the given namespace and name")
 
-	rrOpts.Matchers = *flags.StringArrayP("matchers",
+	flags.StringArrayVarP(&rrOpts.Matchers,
+		"match",
 		"m",
 		nil,
-		"Matcher for this rule")
+		"Matcher for the requests of this rule, can be repeated. "+
+			"Comma-separated clauses such as prefix=/api, exact=PATH, regex=PATH_REGEX, header:NAME=VALUE, header:NAME~=REGEX, "+
+			"query:NAME=VALUE and method=GET|POST, e.g. 'prefix=/api,header:x-user=beta,method=GET'")
+	flags.StringArrayVar(&rrOpts.DeprecatedMatchers, "matchers", nil, "Matcher for this rule")
+	flags.MarkDeprecated("matchers", "use --match instead")
+
+	flags.StringVar(&rrOpts.TrafficShifting,
+		"traffic-shifting",
+		"",
+		"Shift the traffic of this rule between upstreams. Each entry consists of an upstream namespace and name, "+
+			"separated by a colon, and its weight, e.g. 'ns:reviews-v1=90,ns:reviews-v2=10'. The weights must add up to 100")
+
+	flags.DurationVar(&rrOpts.Timeout, "timeout", 0, "Timeout of the requests of this rule, including all their retries")
+	flags.Int32Var(&rrOpts.RetryAttempts, "retries", 0, "Number of retries for the requests of this rule")
+	flags.DurationVar(&rrOpts.RetryPerTryTimeout, "per-try-timeout", 0, "Timeout of each attempt of a request")
+
+	cors := &rrOpts.Cors
+	flags.StringSliceVar(&cors.AllowOrigin, "cors-allow-origin", nil, "Origins allowed to perform CORS requests, '*' allows all origins")
+	flags.StringSliceVar(&cors.AllowMethods, "cors-allow-methods", nil, "HTTP methods allowed in CORS requests")
+	flags.StringSliceVar(&cors.AllowHeaders, "cors-allow-headers", nil, "HTTP headers allowed in CORS requests")
+	flags.StringSliceVar(&cors.ExposeHeaders, "cors-expose-headers", nil, "HTTP headers the browsers are allowed to access")
+	flags.DurationVar(&cors.MaxAge, "cors-max-age", 0, "How long the results of a preflight request can be cached")
+	flags.BoolVar(&cors.AllowCredentials, "cors-allow-credentials", false, "Allow CORS requests with credentials")
+
+	headers := &rrOpts.HeaderManipulation
+	flags.StringArrayVar(&headers.AppendRequestHeaders, "add-request-header", nil, "Header to add to the requests, in the form NAME=VALUE, can be repeated")
+	flags.StringSliceVar(&headers.RemoveRequestHeaders, "remove-request-headers", nil, "Headers to remove from the requests")
+	flags.StringArrayVar(&headers.AppendResponseHeaders, "add-response-header", nil, "Header to add to the responses, in the form NAME=VALUE, can be repeated")
+	flags.StringSliceVar(&headers.RemoveResponseHeaders, "remove-response-headers", nil, "Headers to remove from the responses")
 
 	return cmd
 }
@@ -119,17 +150,13 @@ func createRoutingRule(routeName string, opts *options.Options) error {
 	}
 	var destinations []*glooV1.Upstream
 	if rrOpts.Destinations != "" {
-		sources, err = validateUpstreams(upstreamClient, rrOpts.Destinations)
+		destinations, err = validateUpstreams(upstreamClient, rrOpts.Destinations)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Validate matchers
-	var matchers []*glooV1.Matcher
-	if rrOpts.Matchers != nil {
-		matchers, err = validateMatchers(rrOpts.Matchers)
-	}
+	matchers, err := ensureMatchers(opts)
 	if err != nil {
 		return err
 	}
@@ -147,6 +174,9 @@ func createRoutingRule(routeName string, opts *options.Options) error {
 		Destinations:    toResourceRefs(destinations),
 		RequestMatchers: matchers,
 	}
+	if err := setRuleFeatures(routingRule, rrOpts, upstreamClient); err != nil {
+		return err
+	}
 
 	rrClient, err := common.GetRoutingRuleClient()
 	if err != nil {
@@ -190,61 +220,118 @@ func validateUpstreams(client *glooV1.UpstreamClient, upstreamOption string) ([]
 	return upstreams, nil
 }
 
-func validateMatchers(matcherOption []string) ([]*glooV1.Matcher, error) {
-	result := make([]*glooV1.Matcher, len(matcherOption))
-
-	// Each 'matcher' is one occurrence of the --matchers flag and will result in one glooV1.Matcher object
-	// e.g. matcher = "prefix=/some/path,methods=get|post"
-	for i, matcher := range matcherOption {
-		m := &glooV1.Matcher{}
+// sets the traffic shifting, timeout, retries, cors policy and header manipulation of the rule from the flags
+func setRuleFeatures(rule *superglooV1.RoutingRule, rrOpts *options.RoutingRule, upstreamClient *glooV1.UpstreamClient) error {
+	if rrOpts.TrafficShifting != "" {
+		trafficShifting, err := parseTrafficShifting(upstreamClient, rrOpts.TrafficShifting)
+		if err != nil {
+			return err
+		}
+		rule.TrafficShifting = trafficShifting
+	}
 
-		// 'clauses' is an array of matcher clauses
-		// e.g. clauses = { "prefix=/some/path" , "methods=get|post" }
-		clauses := strings.Split(matcher, common.ListOptionSeparator)
-		for _, clause := range clauses {
+	if rrOpts.Timeout < 0 || rrOpts.RetryAttempts < 0 || rrOpts.RetryPerTryTimeout < 0 {
+		return fmt.Errorf("Retry attempts and timeouts cannot be negative")
+	}
+	if rrOpts.Timeout != 0 {
+		rule.Timeout = types.DurationProto(rrOpts.Timeout)
+	}
+	if rrOpts.RetryAttempts != 0 || rrOpts.RetryPerTryTimeout != 0 {
+		rule.Retries = &v1alpha3.HTTPRetry{Attempts: rrOpts.RetryAttempts}
+		if rrOpts.RetryPerTryTimeout != 0 {
+			rule.Retries.PerTryTimeout = types.DurationProto(rrOpts.RetryPerTryTimeout)
+		}
+	}
+	if err := routerule.ValidateRetries(rule.Retries, rule.Timeout); err != nil {
+		return err
+	}
 
-			// 'parts' are the two parts of the clause, separated by the "=" character
-			// e.g. parts = { "prefix", "/some/path"}
-			parts := strings.Split(clause, "=")
-			if len(parts) != 2 {
-				return nil, fmt.Errorf(common.InvalidOptionFormat, clause, "create routingrule")
+	cors := rrOpts.Cors
+	if len(cors.AllowOrigin) > 0 {
+		rule.CorsPolicy = &v1alpha3.CorsPolicy{
+			AllowOrigin:   cors.AllowOrigin,
+			AllowHeaders:  cors.AllowHeaders,
+			ExposeHeaders: cors.ExposeHeaders,
+		}
+		if len(cors.AllowMethods) > 0 {
+			methods, err := validateMethods(strings.Join(cors.AllowMethods, common.SubListOptionSeparator))
+			if err != nil {
+				return err
 			}
+			rule.CorsPolicy.AllowMethods = methods
+		}
+		if cors.MaxAge != 0 {
+			rule.CorsPolicy.MaxAge = types.DurationProto(cors.MaxAge)
+		}
+		if cors.AllowCredentials {
+			rule.CorsPolicy.AllowCredentials = &types.BoolValue{Value: true}
+		}
+	} else if len(cors.AllowMethods) > 0 || len(cors.AllowHeaders) > 0 || len(cors.ExposeHeaders) > 0 || cors.MaxAge != 0 || cors.AllowCredentials {
+		return fmt.Errorf("Please specify the origins allowed to perform CORS requests with --cors-allow-origin")
+	}
 
-			matcherType, value := parts[0], parts[1]
-			switch matcherType {
-			// TODO: make these (and other string literals around here) constants
-			case "prefix":
-
-				// We can have only one path specifier per matcher
-				if m.PathSpecifier != nil {
-					return nil, errors.Errorf(common.InvalidOptionFormat, clause, "create routingrule")
-				}
-				m.PathSpecifier = &glooV1.Matcher_Prefix{Prefix: value}
-
-			case "methods":
-
-				// If the user specified more than one "methods" clause, return error. We could just merge the two
-				// clauses, but this scenario is most likely an error we want the user to be aware of.
-				if m.Methods != nil {
-					return nil, errors.Errorf(common.InvalidOptionFormat, clause, "create routingrule")
-				}
-
-				methods := strings.Split(value, common.SubListOptionSeparator)
-				validMethods := strings.Split(common.ValidMatcherHttpMethods, common.SubListOptionSeparator)
-				for _, method := range methods {
-					if !common.Contains(validMethods, strings.ToUpper(method)) {
-						return nil, errors.Errorf(common.InvalidMatcherHttpMethod, method)
-					}
-				}
-				m.Methods = methods
-
-			default:
-				return nil, fmt.Errorf(common.InvalidOptionFormat, clause, "create routingrule")
-			}
+	headers := rrOpts.HeaderManipulation
+	if len(headers.AppendRequestHeaders) > 0 || len(headers.RemoveRequestHeaders) > 0 ||
+		len(headers.AppendResponseHeaders) > 0 || len(headers.RemoveResponseHeaders) > 0 {
+		appendRequestHeaders, err := parseHeaders(headers.AppendRequestHeaders, "add-request-header")
+		if err != nil {
+			return err
+		}
+		appendResponseHeaders, err := parseHeaders(headers.AppendResponseHeaders, "add-response-header")
+		if err != nil {
+			return err
+		}
+		rule.HeaderManipulaition = &superglooV1.HeaderManipulation{
+			AppendRequestHeaders:  appendRequestHeaders,
+			RemoveRequestHeaders:  headers.RemoveRequestHeaders,
+			AppendResponseHeaders: appendResponseHeaders,
+			RemoveResponseHeaders: headers.RemoveResponseHeaders,
 		}
+	}
+	return nil
+}
 
-		result[i] = m
+// parses the upstreams and their weights in the form <namespace>:<name>=<weight>, separated by commas
+func parseTrafficShifting(client *glooV1.UpstreamClient, option string) (*superglooV1.TrafficShifting, error) {
+	trafficShifting := &superglooV1.TrafficShifting{}
+	var total uint32
+	for _, entry := range strings.Split(option, common.ListOptionSeparator) {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf(common.InvalidOptionFormat, entry, "create routingrule")
+		}
+		weight, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil || weight == 0 {
+			return nil, fmt.Errorf("invalid weight %v for upstream %v, weights must be greater than zero", parts[1], parts[0])
+		}
+		upstreams, err := validateUpstreams(client, parts[0])
+		if err != nil {
+			return nil, err
+		}
+		trafficShifting.Destinations = append(trafficShifting.Destinations, &superglooV1.WeightedDestination{
+			Upstream: &core.ResourceRef{Name: upstreams[0].Metadata.Name, Namespace: upstreams[0].Metadata.Namespace},
+			Weight:   uint32(weight),
+		})
+		total += uint32(weight)
 	}
+	if total != 100 {
+		return nil, fmt.Errorf("the traffic shifting weights add up to %v, they must add up to 100", total)
+	}
+	return trafficShifting, nil
+}
 
-	return result, nil
+// parses headers in the form <name>=<value>
+func parseHeaders(headers []string, flag string) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	parsed := make(map[string]string)
+	for _, header := range headers {
+		parts := strings.SplitN(header, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid value %v for --%v, headers are in the form NAME=VALUE", header, flag)
+		}
+		parsed[parts[0]] = parts[1]
+	}
+	return parsed, nil
 }
@@ -0,0 +1,157 @@
+package create
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	glooV1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+var _ = Describe("Routing rule options", func() {
+	table.DescribeTable("parses valid matchers",
+		func(matcher string, expected *glooV1.Matcher) {
+			m, err := parseMatcher(matcher)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m).To(Equal(expected))
+		},
+		table.Entry("prefix", "prefix=/api",
+			&glooV1.Matcher{PathSpecifier: &glooV1.Matcher_Prefix{Prefix: "/api"}}),
+		table.Entry("exact", "exact=/api/v1",
+			&glooV1.Matcher{PathSpecifier: &glooV1.Matcher_Exact{Exact: "/api/v1"}}),
+		table.Entry("regex", "regex=/api/v[0-9]+",
+			&glooV1.Matcher{PathSpecifier: &glooV1.Matcher_Regex{Regex: "/api/v[0-9]+"}}),
+		table.Entry("header value", "header:x-user=beta",
+			&glooV1.Matcher{Headers: []*glooV1.HeaderMatcher{{Name: "x-user", Value: "beta"}}}),
+		table.Entry("header regex", "header:x-user~=beta-.*",
+			&glooV1.Matcher{Headers: []*glooV1.HeaderMatcher{{Name: "x-user", Value: "beta-.*", Regex: true}}}),
+		table.Entry("header presence", "header:x-debug",
+			&glooV1.Matcher{Headers: []*glooV1.HeaderMatcher{{Name: "x-debug"}}}),
+		table.Entry("query parameter", "query:version=2",
+			&glooV1.Matcher{QueryParameters: []*glooV1.QueryParameterMatcher{{Name: "version", Value: "2"}}}),
+		table.Entry("methods in any case", "method=get|Post",
+			&glooV1.Matcher{Methods: []string{"GET", "POST"}}),
+		table.Entry("all clauses", "prefix=/api,header:x-user=beta,query:debug,methods=GET",
+			&glooV1.Matcher{
+				PathSpecifier:   &glooV1.Matcher_Prefix{Prefix: "/api"},
+				Headers:         []*glooV1.HeaderMatcher{{Name: "x-user", Value: "beta"}},
+				QueryParameters: []*glooV1.QueryParameterMatcher{{Name: "debug"}},
+				Methods:         []string{"GET"},
+			}),
+	)
+
+	table.DescribeTable("rejects invalid matchers",
+		func(matcher string, expectedErr string) {
+			_, err := parseMatcher(matcher)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		table.Entry("two path specifiers", "prefix=/api,exact=/api", "invalid format for option: exact=/api"),
+		table.Entry("bad method", "method=GET|FETCH", "invalid HTTP method: FETCH"),
+		table.Entry("two method clauses", "method=GET,method=POST", "invalid format for option: method=POST"),
+		table.Entry("header without name", "header:=beta", "invalid format for option: header:=beta"),
+		table.Entry("empty value", "prefix=", "invalid format for option: prefix="),
+		table.Entry("unknown clause", "host=example.com", "invalid format for option: host=example.com"),
+		table.Entry("clause without value", "prefix", "invalid format for option: prefix"),
+	)
+
+	table.DescribeTable("splits names and values",
+		func(clause, name, value string, regex bool) {
+			n, v, r := splitNameValue(clause)
+			Expect(n).To(Equal(name))
+			Expect(v).To(Equal(value))
+			Expect(r).To(Equal(regex))
+		},
+		table.Entry("value", "x-user=beta", "x-user", "beta", false),
+		table.Entry("regex", "x-user~=beta.*", "x-user", "beta.*", true),
+		table.Entry("value with equal sign", "token=a=b", "token", "a=b", false),
+		table.Entry("name only", "x-debug", "x-debug", "", false),
+	)
+
+	It("keeps the matchers of both --match and the deprecated --matchers", func() {
+		opts := &options.Options{Top: options.Top{Static: true}}
+		cmd := RoutingRuleCmd(opts)
+		err := cmd.ParseFlags([]string{"--match", "prefix=/a", "--matchers", "prefix=/b", "-m", "prefix=/c"})
+		Expect(err).NotTo(HaveOccurred())
+		matchers, err := ensureMatchers(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(matchers).To(Equal([]*glooV1.Matcher{
+			{PathSpecifier: &glooV1.Matcher_Prefix{Prefix: "/a"}},
+			{PathSpecifier: &glooV1.Matcher_Prefix{Prefix: "/c"}},
+			{PathSpecifier: &glooV1.Matcher_Prefix{Prefix: "/b"}},
+		}))
+	})
+
+	Describe("traffic shifting", func() {
+		var upstreamClient glooV1.UpstreamClient
+
+		BeforeEach(func() {
+			var err error
+			upstreamClient, err = glooV1.NewUpstreamClient(&factory.MemoryResourceClientFactory{
+				Cache: memory.NewInMemoryResourceCache(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			for _, name := range []string{"reviews-v1", "reviews-v2"} {
+				_, err = upstreamClient.Write(&glooV1.Upstream{
+					Metadata: core.Metadata{Name: name, Namespace: "default"},
+				}, clients.WriteOpts{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("parses the weighted upstreams", func() {
+			trafficShifting, err := parseTrafficShifting(&upstreamClient, "default:reviews-v1=90,default:reviews-v2=10")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(trafficShifting).To(Equal(&superglooV1.TrafficShifting{
+				Destinations: []*superglooV1.WeightedDestination{
+					{Upstream: &core.ResourceRef{Name: "reviews-v1", Namespace: "default"}, Weight: 90},
+					{Upstream: &core.ResourceRef{Name: "reviews-v2", Namespace: "default"}, Weight: 10},
+				},
+			}))
+		})
+
+		table.DescribeTable("rejects invalid traffic shifting",
+			func(option string, expectedErr string) {
+				_, err := parseTrafficShifting(&upstreamClient, option)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedErr))
+			},
+			table.Entry("weights not adding up to 100", "default:reviews-v1=50,default:reviews-v2=20",
+				"the traffic shifting weights add up to 70, they must add up to 100"),
+			table.Entry("zero weight", "default:reviews-v1=100,default:reviews-v2=0",
+				"invalid weight 0 for upstream default:reviews-v2"),
+			table.Entry("weight which is not a number", "default:reviews-v1=all",
+				"invalid weight all for upstream default:reviews-v1"),
+			table.Entry("missing weight", "default:reviews-v1", "invalid format for option: default:reviews-v1"),
+			table.Entry("upstream without namespace", "reviews-v1=100", "invalid format for option: reviews-v1"),
+			table.Entry("missing upstream", "default:reviews-v3=100", "does not exist"),
+		)
+	})
+
+	table.DescribeTable("parses headers",
+		func(headers []string, expected map[string]string) {
+			parsed, err := parseHeaders(headers, "add-request-header")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(expected))
+		},
+		table.Entry("no headers", nil, nil),
+		table.Entry("headers", []string{"x-user=beta", "x-empty="},
+			map[string]string{"x-user": "beta", "x-empty": ""}),
+		table.Entry("value with equal sign", []string{"cookie=a=b"}, map[string]string{"cookie": "a=b"}),
+	)
+
+	table.DescribeTable("rejects invalid headers",
+		func(header string) {
+			_, err := parseHeaders([]string{header}, "add-request-header")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("invalid value " + header + " for --add-request-header, headers are in the form NAME=VALUE"))
+		},
+		table.Entry("no value", "x-user"),
+		table.Entry("no name", "=beta"),
+	)
+})
@@ -0,0 +1,19 @@
+package describe
+
+import (
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/pkg/constants"
+	"github.com/spf13/cobra"
+)
+
+func Cmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: `Show the details of a supergloo resource`,
+		Long:  `Show the details of a supergloo resource, along with the resources related to it`,
+	}
+	cmd.PersistentFlags().StringVarP(&opts.Describe.Namespace, "namespace", "n", constants.SuperglooNamespace,
+		"Namespace of the resource")
+	cmd.AddCommand(meshCmd(opts))
+	return cmd
+}
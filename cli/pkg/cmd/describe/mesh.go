@@ -0,0 +1,398 @@
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/get/info"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/get/printers"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/cli/pkg/setup"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	istiosecret "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/rbac/v1alpha1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/constants"
+	"github.com/solo-io/supergloo/pkg/translator/istio"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
+	"github.com/spf13/cobra"
+	kubecore "k8s.io/api/core/v1"
+	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// annotation of the istio resources written by supergloo
+const createdByAnnotation = "created_by"
+
+func meshCmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mesh NAME",
+		Short: `Show the details of a mesh`,
+		Long: `Show a mesh along with its install, helm release, encryption secret, policy rules, the routing rules
+which target it, the istio resources supergloo wrote for it and the readiness of its control plane pods`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			return describeMesh(os.Stdout, opts.Describe.Namespace, args[0])
+		},
+	}
+	return cmd
+}
+
+// an istio resource written by supergloo, along with its kind
+type istioResource struct {
+	kind     string
+	resource resources.Resource
+}
+
+// the istio resources which can be written by supergloo
+var istioKinds = []struct {
+	crd      crd.Crd
+	resource resources.InputResource
+}{
+	{crd: v1alpha3.DestinationRuleCrd, resource: &v1alpha3.DestinationRule{}},
+	{crd: v1alpha3.VirtualServiceCrd, resource: &v1alpha3.VirtualService{}},
+	{crd: v1alpha1.RbacConfigCrd, resource: &v1alpha1.RbacConfig{}},
+	{crd: v1alpha1.ServiceRoleCrd, resource: &v1alpha1.ServiceRole{}},
+	{crd: v1alpha1.ServiceRoleBindingCrd, resource: &v1alpha1.ServiceRoleBinding{}},
+}
+
+func describeMesh(out io.Writer, namespace, name string) error {
+	// aligns the fields of the mesh, the tables of the sections are aligned on their own
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	cfg, err := common.GetKubernetesConfig()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := common.GetKubernetesClient()
+	if err != nil {
+		return err
+	}
+	meshClient, err := common.GetMeshClient()
+	if err != nil {
+		return err
+	}
+	mesh, err := (*meshClient).Read(namespace, name, clients.ReadOpts{})
+	if err != nil {
+		return errors.Wrapf(err, "reading mesh %v.%v", namespace, name)
+	}
+
+	meshType, installationNamespace := meshTypeOf(mesh)
+	fmt.Fprintf(w, "Name:\t%v\n", mesh.Metadata.Name)
+	fmt.Fprintf(w, "Namespace:\t%v\n", mesh.Metadata.Namespace)
+	fmt.Fprintf(w, "Type:\t%v\n", meshType)
+	fmt.Fprintf(w, "Installation Namespace:\t%v\n", installationNamespace)
+	fmt.Fprintf(w, "Status:\t%v\n", statusOf(mesh.Status))
+	fmt.Fprintf(w, "Helm Release:\t%v\n", orNone(mesh.Metadata.Annotations[constants.HelmReleaseAnnotation]))
+
+	if err := describeInstall(w, mesh); err != nil {
+		return err
+	}
+	if err := describeEncryption(w, kubeClient, mesh); err != nil {
+		return err
+	}
+
+	mpClient, err := common.GetMeshPolicyClient()
+	if err != nil {
+		return err
+	}
+	// mesh policies can target the mesh from any namespace
+	meshPolicies, err := (*mpClient).List("", clients.ListOpts{})
+	if err != nil {
+		return err
+	}
+	usClient, err := common.GetUpstreamClient()
+	if err != nil {
+		return err
+	}
+	upstreams, err := (*usClient).List("", clients.ListOpts{})
+	if err != nil {
+		return err
+	}
+	policy := shared.MergedPolicy(mesh, meshPolicies, upstreams)
+	if policy != nil {
+		namespaces := "all"
+		if len(policy.Namespaces) > 0 {
+			namespaces = strings.Join(policy.Namespaces, ", ")
+		}
+		fmt.Fprintf(w, "\nPolicy Mode:\t%v\n", policy.Mode)
+		fmt.Fprintf(w, "Policy Namespaces:\t%v\n", namespaces)
+	}
+	fmt.Fprintf(w, "\nPolicy Rules:\n")
+	policies := info.FromPolicies(v1.MeshList{mesh}, meshPolicies)
+	if err := printSection(w, policies, options.Get{}); err != nil {
+		return err
+	}
+
+	if err := describeRoutingRules(w, cfg, mesh); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nIstio Resources:\n")
+	if mesh.GetIstio() == nil {
+		fmt.Fprintf(w, "  supergloo writes istio resources for istio meshes only\n")
+	} else if err := describeIstioResources(w, cfg, kubeClient, mesh, upstreams, policy); err != nil {
+		return err
+	}
+
+	return describeControlPlane(w, kubeClient, installationNamespace)
+}
+
+// the install which created the mesh has the same name
+func describeInstall(w io.Writer, mesh *v1.Mesh) error {
+	installClient, err := common.GetInstallClient()
+	if err != nil {
+		return err
+	}
+	install, err := (*installClient).Read(mesh.Metadata.Namespace, mesh.Metadata.Name, clients.ReadOpts{})
+	if err != nil {
+		fmt.Fprintf(w, "Install:\t<none>\n")
+		return nil
+	}
+	installEnabled := install.Enabled == nil || install.Enabled.Value
+	fmt.Fprintf(w, "Install:\t%v (enabled: %v, chart: %v, status: %v)\n", install.Metadata.Ref().Key(),
+		strconv.FormatBool(installEnabled), orNone(install.GetChartLocator().GetChartPath().GetPath()), statusOf(install.Status))
+	return nil
+}
+
+func describeEncryption(w io.Writer, kubeClient *kubernetes.Clientset, mesh *v1.Mesh) error {
+	encryption := mesh.GetEncryption()
+	fmt.Fprintf(w, "\nEncryption:\n")
+	fmt.Fprintf(w, "  TLS Enabled:\t%v\n", encryption.GetTlsEnabled())
+	ref := encryption.GetSecret()
+	if ref == nil {
+		fmt.Fprintf(w, "  Secret:\t<none>\n")
+		return nil
+	}
+	fmt.Fprintf(w, "  Secret:\t%v\n", ref.Key())
+	secretClient, err := common.GetSecretClient()
+	if err != nil {
+		return err
+	}
+	secret, err := (*secretClient).Read(ref.Namespace, ref.Name, clients.ReadOpts{})
+	if err != nil {
+		fmt.Fprintf(w, "  the secret cannot be read: %v\n", err)
+		return nil
+	}
+	created := make(info.CreationTimestamps)
+	if kubeSecret, err := kubeClient.CoreV1().Secrets(ref.Namespace).Get(ref.Name, kubemeta.GetOptions{}); err == nil {
+		created[*ref] = kubeSecret.CreationTimestamp.Time
+	}
+	return printSection(w, info.FromSecretList(istiosecret.IstioCacertsSecretList{secret}, created, time.Now()), options.Get{Output: "wide"})
+}
+
+func describeRoutingRules(w io.Writer, cfg *rest.Config, mesh *v1.Mesh) error {
+	rrClient, err := common.GetRoutingRuleClient()
+	if err != nil {
+		return err
+	}
+	// routing rules can target a mesh from any namespace
+	rules, err := (*rrClient).List("", clients.ListOpts{})
+	if err != nil {
+		return err
+	}
+	var meshRules v1.RoutingRuleList
+	for _, rule := range rules {
+		if rule.TargetMesh != nil && *rule.TargetMesh == mesh.Metadata.Ref() {
+			meshRules = append(meshRules, rule)
+		}
+	}
+	created, err := info.ReadCreationTimestamps(cfg, v1.RoutingRuleCrd, "")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\nRouting Rules:\n")
+	return printSection(w, info.FromRoutingRuleList(meshRules, created), options.Get{AllNamespaces: true})
+}
+
+// the istio resources supergloo wrote for the mesh: those the translator syncers write for its routing rules
+// and its policy, which supergloo marks with the created_by annotation once written.
+// the rbac config is shared by all the istio meshes with a policy
+func describeIstioResources(w io.Writer, cfg *rest.Config, kubeClient *kubernetes.Clientset, mesh *v1.Mesh, upstreams gloov1.UpstreamList, policy *v1.Policy) error {
+	forMesh, err := istioResourcesForMesh(kubeClient, mesh, upstreams, policy)
+	if err != nil {
+		return err
+	}
+	cache := kube.NewKubeCache()
+	var written []istioResource
+	for _, kind := range istioKinds {
+		rcFactory := &factory.KubeResourceClientFactory{
+			Crd:         kind.crd,
+			Cfg:         cfg,
+			SharedCache: cache,
+		}
+		rc, err := rcFactory.NewResourceClient(factory.NewResourceClientParams{ResourceType: kind.resource})
+		if err != nil {
+			return err
+		}
+		if err := rc.Register(); err != nil {
+			return err
+		}
+		list, err := rc.List("", clients.ListOpts{})
+		if err != nil {
+			return err
+		}
+		for _, res := range list {
+			if res.GetMetadata().Annotations[createdByAnnotation] != "supergloo" || !forMesh[istioResourceKey(kind.crd, res)] {
+				continue
+			}
+			written = append(written, istioResource{kind: kind.crd.KindName, resource: res})
+		}
+	}
+	sort.SliceStable(written, func(i, j int) bool {
+		if written[i].kind != written[j].kind {
+			return written[i].kind < written[j].kind
+		}
+		return written[i].resource.GetMetadata().Ref().Key() < written[j].resource.GetMetadata().Ref().Key()
+	})
+	var rows [][]string
+	for _, res := range written {
+		meta := res.resource.GetMetadata()
+		rows = append(rows, []string{res.kind, meta.Namespace, meta.Name})
+	}
+	return printTable(w, []string{"KIND", "NAMESPACE", "NAME"}, rows)
+}
+
+// the keys of the istio resources the translator syncers write for the routing rules targeting the mesh
+// and for its policy. invalid routing rules and the policy rules which cannot be applied are left out,
+// they are reported on the status of the rules and the mesh
+func istioResourcesForMesh(kubeClient *kubernetes.Clientset, mesh *v1.Mesh, upstreams gloov1.UpstreamList, policy *v1.Policy) (map[string]bool, error) {
+	rrClient, err := common.GetRoutingRuleClient()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := (*rrClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	// the rules targeting other meshes are left out of the translation of this one
+	snap := &v1.TranslatorSnapshot{
+		Meshes:       v1.MeshList{mesh}.ByNamespace(),
+		Routingrules: rules.ByNamespace(),
+		Upstreams:    upstreams.ByNamespace(),
+	}
+	keys := make(map[string]bool)
+	destinationRules, virtualServices, _ := istio.TranslateRoutingRules(snap, nil, make(reporter.ResourceErrors))
+	for _, res := range destinationRules {
+		keys[istioResourceKey(v1alpha3.DestinationRuleCrd, res)] = true
+	}
+	for _, res := range virtualServices {
+		keys[istioResourceKey(v1alpha3.VirtualServiceCrd, res)] = true
+	}
+	if policy == nil {
+		return keys, nil
+	}
+	rbacConfig, serviceRoles, serviceRoleBindings, _ := istio.TranslatePolicy(constants.SuperglooNamespace, nil, kubeClient, snap.Upstreams, policy)
+	keys[istioResourceKey(v1alpha1.RbacConfigCrd, rbacConfig)] = true
+	for _, res := range serviceRoles {
+		keys[istioResourceKey(v1alpha1.ServiceRoleCrd, res)] = true
+	}
+	for _, res := range serviceRoleBindings {
+		keys[istioResourceKey(v1alpha1.ServiceRoleBindingCrd, res)] = true
+	}
+	return keys, nil
+}
+
+func istioResourceKey(kind crd.Crd, res resources.Resource) string {
+	return kind.KindName + " " + res.GetMetadata().Ref().Key()
+}
+
+// the pods of the installation namespace of the mesh, with the readiness check used when installing supergloo
+func describeControlPlane(w io.Writer, kubeClient *kubernetes.Clientset, installationNamespace string) error {
+	fmt.Fprintf(w, "\nControl Plane:\n")
+	if installationNamespace == "" {
+		fmt.Fprintf(w, "  the mesh has no installation namespace\n")
+		return nil
+	}
+	pods, err := kubeClient.CoreV1().Pods(installationNamespace).List(kubemeta.ListOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "  Ready:\t%v\n", setup.AllPodsReadyOrSucceeded(installationNamespace, kubeClient))
+	var rows [][]string
+	for _, pod := range pods.Items {
+		rows = append(rows, []string{pod.Name, strconv.FormatBool(setup.PodReadyOrSucceeded(pod)),
+			string(pod.Status.Phase), strconv.Itoa(restarts(pod))})
+	}
+	return printTable(w, []string{"POD", "READY", "PHASE", "RESTARTS"}, rows)
+}
+
+func restarts(pod kubecore.Pod) int {
+	var count int
+	for _, container := range pod.Status.ContainerStatuses {
+		count += int(container.RestartCount)
+	}
+	return count
+}
+
+func meshTypeOf(mesh *v1.Mesh) (string, string) {
+	switch x := mesh.MeshType.(type) {
+	case *v1.Mesh_Istio:
+		return common.Istio, x.Istio.GetInstallationNamespace()
+	case *v1.Mesh_Consul:
+		return common.Consul, x.Consul.GetInstallationNamespace()
+	case *v1.Mesh_Linkerd2:
+		return common.Linkerd2, x.Linkerd2.GetInstallationNamespace()
+	}
+	return "<unknown>", ""
+}
+
+func statusOf(status core.Status) string {
+	if status.Reason == "" {
+		return status.State.String()
+	}
+	return fmt.Sprintf("%v: %v", status.State.String(), status.Reason)
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// prints the resources as a table, or <none> if there are none
+func printSection(w io.Writer, resourceInfo *info.ResourceInfo, opts options.Get) error {
+	rows := resourceInfo.Resources(opts)
+	if len(rows) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+		return nil
+	}
+	return printTable(w, resourceInfo.Headers(opts), rows)
+}
+
+// prints the table indented below the title of its section
+func printTable(w io.Writer, headers []string, rows [][]string) error {
+	var buf bytes.Buffer
+	if err := printers.Table(&buf, headers, rows); err != nil {
+		return err
+	}
+	for _, line := range strings.SplitAfter(buf.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		if _, err := io.WriteString(w, "  "+line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package get
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,13 +9,16 @@ import (
 	"github.com/solo-io/supergloo/cli/pkg/cmd/get/info"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/get/printers"
 	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/pkg/constants"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
 	"github.com/spf13/cobra"
 )
 
-var supportedOutputFormats = []string{"wide"}
+var supportedOutputFormats = []string{"wide", printers.Json, printers.Yaml, printers.JsonPath + "TEMPLATE", printers.GoTemplate + "TEMPLATE"}
 
 func Cmd(opts *options.Options) *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,16 +36,26 @@ func Cmd(opts *options.Options) *cobra.Command {
 	pFlags := cmd.Flags()
 	pFlags.StringVarP(&getOpts.Output, "output", "o", "",
 		"Output format. Must be one of: \n"+strings.Join(supportedOutputFormats, "|"))
+	pFlags.StringVarP(&getOpts.Namespace, "namespace", "n", "",
+		"Namespace of the resources. Defaults to \""+constants.SuperglooNamespace+"\", or \""+constants.GlooNamespace+"\" for upstreams")
+	pFlags.BoolVarP(&getOpts.AllNamespaces, "all-namespaces", "A", false,
+		"List the resources of all namespaces")
+	pFlags.BoolVarP(&getOpts.Watch, "watch", "w", false,
+		"After listing the resources, watch for changes and print the resources which changed. Only supported for meshes and routingrules")
 	return cmd
 }
 
 func get(args []string, opts *options.Options) error {
 
 	output := opts.Get.Output
-	if output != "" && !common.Contains(supportedOutputFormats, output) {
+	if output != "" && output != "wide" && !printers.IsStructured(output) {
 		return errors.Errorf(common.UnknownOutputFormat, output, strings.Join(supportedOutputFormats, "|"))
 	}
 
+	if opts.Get.AllNamespaces && opts.Get.Namespace != "" {
+		return errors.Errorf("--namespace cannot be combined with --all-namespaces")
+	}
+
 	if argNumber := len(args); argNumber == 1 {
 		return getResource(args[0], "", opts.Get)
 	} else {
@@ -68,21 +82,95 @@ func getResource(resourceType, resourceName string, opts options.Get) error {
 	}
 
 	// Fetch the resource information
-	resourceInfo, err := infoClient.ListResources(resourceType, resourceName)
+	namespace := opts.Namespace
+	if opts.AllNamespaces {
+		namespace = ""
+	} else if namespace == "" && resourceType == "upstreams" {
+		namespace = constants.GlooNamespace
+	} else if namespace == "" {
+		namespace = constants.SuperglooNamespace
+	}
+	if opts.Watch {
+		return watchResource(infoClient, resourceType, namespace, resourceName, opts)
+	}
+	resourceInfo, err := infoClient.ListResources(resourceType, namespace, resourceName)
 	if err != nil {
 		return err
 	}
 
 	// Write the resource information to stdout
-	writer := printers.NewTableWriter(os.Stdout)
-	if err = writer.WriteLine(resourceInfo.Headers(opts)); err != nil {
+	if printers.IsStructured(opts.Output) {
+		return printers.Structured(os.Stdout, opts.Output, resourceInfo.Items(), resourceName == "")
+	}
+	return printers.Table(os.Stdout, resourceInfo.Headers(opts), resourceInfo.Resources(opts))
+}
+
+// As with kubectl, the table is printed first, then the rows of the resources which were added or changed.
+// Structured output formats print the changed resources one by one
+func watchResource(infoClient info.SuperglooInfoClient, resourceType, namespace, resourceName string, opts options.Get) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	infos, errs, err := infoClient.WatchResources(ctx, resourceType, namespace, resourceName)
+	if err != nil {
 		return err
 	}
-	for _, line := range resourceInfo.Resources(opts) {
-		if err = writer.WriteLine(line); err != nil {
-			return err
+
+	// the resources as they were last printed
+	printed := make(map[string]proto.Message)
+	first := true
+	for {
+		select {
+		case err := <-errs:
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		case resourceInfo, ok := <-infos:
+			if !ok {
+				return nil
+			}
+			if err := printChanges(resourceInfo, opts, printed, first); err != nil {
+				return err
+			}
+			first = false
+		}
+	}
+}
+
+func printChanges(resourceInfo *info.ResourceInfo, opts options.Get, printed map[string]proto.Message, first bool) error {
+	rows := resourceInfo.Resources(opts)
+	var (
+		changedRows  [][]string
+		changedItems []proto.Message
+	)
+	current := make(map[string]bool)
+	for i, item := range resourceInfo.Items() {
+		key := fmt.Sprintf("%v", i)
+		if res, ok := item.(resources.Resource); ok {
+			key = res.GetMetadata().Ref().Key()
+		}
+		current[key] = true
+		if previous, ok := printed[key]; ok && proto.Equal(previous, item) {
+			continue
+		}
+		printed[key] = item
+		changedRows = append(changedRows, rows[i])
+		changedItems = append(changedItems, item)
+	}
+	// deleted resources are printed again if they are created again
+	for key := range printed {
+		if !current[key] {
+			delete(printed, key)
 		}
 	}
 
-	return writer.Flush()
+	if printers.IsStructured(opts.Output) {
+		for _, item := range changedItems {
+			if err := printers.Structured(os.Stdout, opts.Output, []proto.Message{item}, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if first {
+		return printers.Table(os.Stdout, resourceInfo.Headers(opts), changedRows)
+	}
+	return printers.Rows(os.Stdout, changedRows)
 }
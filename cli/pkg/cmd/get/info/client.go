@@ -1,31 +1,49 @@
 package info
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/solo-io/solo-kit/pkg/errors"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd/client/clientset/versioned"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/supergloo/cli/pkg/common"
-	sgConstants "github.com/solo-io/supergloo/pkg/constants"
 
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	glooV1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	istiosecret "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
 	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
 	k8sApiExt "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
+// resource types which are not supergloo CRDs, but can be listed as well
+var additionalResourceTypes = []string{"policies", "secrets", "upstreams"}
+
 type SuperglooInfoClient interface {
 	ListResourceTypes() ([]string, error)
-	ListResources(resourceType, resourceName string) (*ResourceInfo, error)
+	// lists the resources of the given type in the namespace, or in all namespaces if namespace is empty.
+	// if resourceName is not empty, only the resource with that name is listed
+	ListResources(resourceType, namespace, resourceName string) (*ResourceInfo, error)
+	// the same as ListResources, but emits the resources again each time they change until the context is done
+	WatchResources(ctx context.Context, resourceType, namespace, resourceName string) (<-chan *ResourceInfo, <-chan error, error)
 }
 
 type KubernetesInfoClient struct {
+	kubeConfig         *rest.Config
+	kubeClient         *kubernetes.Clientset
 	kubeCrdClient      *k8sApiExt.CustomResourceDefinitionInterface
 	meshClient         *superglooV1.MeshClient
+	meshPolicyClient   *superglooV1.MeshPolicyClient
 	routingRulesClient *superglooV1.RoutingRuleClient
+	installClient      *superglooV1.InstallClient
 }
 
 func NewClient() (SuperglooInfoClient, error) {
@@ -39,20 +57,39 @@ func NewClient() (SuperglooInfoClient, error) {
 		return nil, err
 	}
 
+	kubeClient, err := common.GetKubernetesClient()
+	if err != nil {
+		return nil, err
+	}
+
 	meshClient, err := common.GetMeshClient()
 	if err != nil {
 		return nil, err
 	}
 
+	meshPolicyClient, err := common.GetMeshPolicyClient()
+	if err != nil {
+		return nil, err
+	}
+
 	rrClient, err := common.GetRoutingRuleClient()
 	if err != nil {
 		return nil, err
 	}
 
+	installClient, err := common.GetInstallClient()
+	if err != nil {
+		return nil, err
+	}
+
 	client := &KubernetesInfoClient{
+		kubeConfig:         config,
+		kubeClient:         kubeClient,
 		kubeCrdClient:      crdClient,
 		meshClient:         meshClient,
+		meshPolicyClient:   meshPolicyClient,
 		routingRulesClient: rrClient,
+		installClient:      installClient,
 	}
 
 	return client, nil
@@ -74,46 +111,236 @@ func (client *KubernetesInfoClient) ListResourceTypes() ([]string, error) {
 			}
 		}
 	}
-	return superglooCRDs, nil
+	return append(superglooCRDs, additionalResourceTypes...), nil
 }
 
-func (client *KubernetesInfoClient) ListResources(resourceType, resourceName string) (*ResourceInfo, error) {
+func (client *KubernetesInfoClient) ListResources(resourceType, namespace, resourceName string) (*ResourceInfo, error) {
+	if namespace == "" && resourceName != "" {
+		return nil, errors.Errorf("a resource cannot be retrieved by name across all namespaces")
+	}
 	// TODO(marco): make code more generic. Ideally we don't want to enumerate the different options, but I could not
 	// find an interface that all of the generated clients implement
 	switch resourceType {
 	case "meshes":
-		if resourceName == "" {
-			meshList, err := (*client.meshClient).List(sgConstants.SuperglooNamespace, clients.ListOpts{})
+		return client.listMeshes(namespace, resourceName)
+	case "routingrules":
+		return client.listRoutingRules(namespace, resourceName)
+	case "installs":
+		return client.listInstalls(namespace, resourceName)
+	case "policies":
+		// policies are part of the meshes, they are listed by the name of their mesh
+		meshList, err := client.readMeshes(namespace, resourceName)
+		if err != nil {
+			return nil, err
+		}
+		// along with the rules of the mesh policies targeting them from any namespace
+		meshPolicyList, err := (*client.meshPolicyClient).List("", clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		return FromPolicies(meshList, meshPolicyList), nil
+	case "meshpolicies":
+		return client.listMeshPolicies(namespace, resourceName)
+	case "secrets":
+		return client.listSecrets(namespace, resourceName)
+	case "upstreams":
+		return client.listUpstreams(namespace, resourceName)
+	default:
+		return nil, errors.Errorf("listing %v is not supported", resourceType)
+	}
+}
+
+func (client *KubernetesInfoClient) readMeshes(namespace, resourceName string) (superglooV1.MeshList, error) {
+	if resourceName == "" {
+		return (*client.meshClient).List(namespace, clients.ListOpts{})
+	}
+	mesh, err := (*client.meshClient).Read(namespace, resourceName, clients.ReadOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return superglooV1.MeshList{mesh}, nil
+}
+
+func (client *KubernetesInfoClient) listMeshes(namespace, resourceName string) (*ResourceInfo, error) {
+	meshList, err := client.readMeshes(namespace, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	created, err := client.creationTimestamps(superglooV1.MeshCrd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return FromMeshList(meshList, created), nil
+}
+
+func (client *KubernetesInfoClient) listRoutingRules(namespace, resourceName string) (*ResourceInfo, error) {
+	var rrList superglooV1.RoutingRuleList
+	if resourceName == "" {
+		list, err := (*client.routingRulesClient).List(namespace, clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		rrList = list
+	} else {
+		rr, err := (*client.routingRulesClient).Read(namespace, resourceName, clients.ReadOpts{})
+		if err != nil {
+			return nil, err
+		}
+		rrList = superglooV1.RoutingRuleList{rr}
+	}
+	created, err := client.creationTimestamps(superglooV1.RoutingRuleCrd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return FromRoutingRuleList(rrList, created), nil
+}
+
+func (client *KubernetesInfoClient) listMeshPolicies(namespace, resourceName string) (*ResourceInfo, error) {
+	var meshPolicyList superglooV1.MeshPolicyList
+	if resourceName == "" {
+		list, err := (*client.meshPolicyClient).List(namespace, clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		meshPolicyList = list
+	} else {
+		meshPolicy, err := (*client.meshPolicyClient).Read(namespace, resourceName, clients.ReadOpts{})
+		if err != nil {
+			return nil, err
+		}
+		meshPolicyList = superglooV1.MeshPolicyList{meshPolicy}
+	}
+	created, err := client.creationTimestamps(superglooV1.MeshPolicyCrd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return FromMeshPolicyList(meshPolicyList, created), nil
+}
+
+func (client *KubernetesInfoClient) listInstalls(namespace, resourceName string) (*ResourceInfo, error) {
+	var installList superglooV1.InstallList
+	if resourceName == "" {
+		list, err := (*client.installClient).List(namespace, clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		installList = list
+	} else {
+		install, err := (*client.installClient).Read(namespace, resourceName, clients.ReadOpts{})
+		if err != nil {
+			return nil, err
+		}
+		installList = superglooV1.InstallList{install}
+	}
+	// the meshes created by the installs hold the names of their helm releases
+	meshList, err := (*client.meshClient).List(namespace, clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	created, err := client.creationTimestamps(superglooV1.InstallCrd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return FromInstallList(installList, meshList, created), nil
+}
+
+func (client *KubernetesInfoClient) listSecrets(namespace, resourceName string) (*ResourceInfo, error) {
+	// built here rather than with the other clients, as it registers the istio secret crd
+	secretClient, err := common.GetSecretClient()
+	if err != nil {
+		return nil, err
+	}
+	var secretList istiosecret.IstioCacertsSecretList
+	if resourceName == "" {
+		// the secret client lists the default namespace rather than all of them
+		namespaces := []string{namespace}
+		if namespace == "" {
+			nsList, err := client.kubeClient.CoreV1().Namespaces().List(k8s.ListOptions{})
 			if err != nil {
 				return nil, err
 			}
-			return FromMeshList(&meshList), nil
-		} else {
-			mesh, err := (*client.meshClient).Read(sgConstants.SuperglooNamespace, resourceName, clients.ReadOpts{})
-			if err != nil {
-				return nil, err
+			namespaces = nil
+			for _, ns := range nsList.Items {
+				namespaces = append(namespaces, ns.Name)
 			}
-			return FromMesh(mesh), nil
 		}
-	case "routingrules":
-		if resourceName == "" {
-			// TODO: replace with supergloo namespace
-			rrList, err := (*client.routingRulesClient).List("default", clients.ListOpts{})
-			if err != nil {
-				return nil, err
-			}
-			return FromRoutingRuleList(&rrList), nil
-		} else {
-			rr, err := (*client.routingRulesClient).Read("default", resourceName, clients.ReadOpts{})
+		for _, ns := range namespaces {
+			list, err := (*secretClient).List(ns, clients.ListOpts{})
 			if err != nil {
 				return nil, err
 			}
-			return FromRoutingRule(rr), nil
+			secretList = append(secretList, list...)
 		}
-	default:
-		// Should not happen since we validate the resource
-		return nil, errors.Errorf(common.UnknownResourceTypeMsg, resourceType)
+	} else {
+		secret, err := (*secretClient).Read(namespace, resourceName, clients.ReadOpts{})
+		if err != nil {
+			return nil, err
+		}
+		secretList = istiosecret.IstioCacertsSecretList{secret}
+	}
+	secrets, err := client.kubeClient.CoreV1().Secrets(namespace).List(k8s.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	created := make(CreationTimestamps)
+	for _, secret := range secrets.Items {
+		created[core.ResourceRef{Name: secret.Name, Namespace: secret.Namespace}] = secret.CreationTimestamp.Time
+	}
+	return FromSecretList(secretList, created, time.Now()), nil
+}
+
+func (client *KubernetesInfoClient) listUpstreams(namespace, resourceName string) (*ResourceInfo, error) {
+	// built here rather than with the other clients, as it registers the gloo upstream crd
+	upstreamClient, err := common.GetUpstreamClient()
+	if err != nil {
+		return nil, err
+	}
+	var upstreamList glooV1.UpstreamList
+	if resourceName == "" {
+		list, err := (*upstreamClient).List(namespace, clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		upstreamList = list
+	} else {
+		upstream, err := (*upstreamClient).Read(namespace, resourceName, clients.ReadOpts{})
+		if err != nil {
+			return nil, err
+		}
+		upstreamList = glooV1.UpstreamList{upstream}
+	}
+	// upstreams belong to meshes in any namespace
+	meshList, err := (*client.meshClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	created, err := client.creationTimestamps(glooV1.UpstreamCrd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return FromUpstreamList(upstreamList, meshList, created), nil
+}
+
+func (client *KubernetesInfoClient) creationTimestamps(def crd.Crd, namespace string) (CreationTimestamps, error) {
+	return ReadCreationTimestamps(client.kubeConfig, def, namespace)
+}
+
+// The generated clients drop the kubernetes metadata which is not part of the solo-kit metadata,
+// so the creation timestamps are read from the custom resources themselves
+func ReadCreationTimestamps(config *rest.Config, def crd.Crd, namespace string) (CreationTimestamps, error) {
+	crdClient, err := versioned.NewForConfig(config, def)
+	if err != nil {
+		return nil, err
+	}
+	list, err := crdClient.ResourcesV1().Resources(namespace).List(k8s.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	created := make(CreationTimestamps)
+	for _, res := range list.Items {
+		created[core.ResourceRef{Name: res.Name, Namespace: res.Namespace}] = res.CreationTimestamp.Time
 	}
+	return created, nil
 }
 
 // Return a client to query kubernetes CRDs
@@ -0,0 +1,58 @@
+package info
+
+import (
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/constants"
+)
+
+const (
+	enabled = "ENABLED"
+	release = "RELEASE"
+	chart   = "CHART"
+)
+
+var installHeaders = []Header{
+	{Name: name, WideOnly: false},
+	{Name: meshType, WideOnly: false},
+	{Name: enabled, WideOnly: false},
+	{Name: release, WideOnly: false},
+	{Name: status, WideOnly: false},
+	{Name: age, WideOnly: false},
+	{Name: installationNamespace, WideOnly: true},
+	{Name: chart, WideOnly: true},
+	{Name: reason, WideOnly: true},
+}
+
+// the helm release of an install is recorded on the mesh it created, which has the same name
+func FromInstallList(list v1.InstallList, meshes v1.MeshList, created CreationTimestamps) *ResourceInfo {
+	var data Data = make([]map[string]string, 0)
+	var items []proto.Message
+	for _, install := range list {
+		data = append(data, transformInstall(install, meshes, created))
+		items = append(items, install)
+	}
+	return &ResourceInfo{headers: installHeaders, data: data, items: items}
+}
+
+func transformInstall(install *v1.Install, meshes v1.MeshList, created CreationTimestamps) map[string]string {
+	fieldMap := commonFields(install.Metadata, install.Status, created)
+	switch x := install.MeshType.(type) {
+	case *v1.Install_Istio:
+		fieldMap[meshType], fieldMap[installationNamespace] = common.Istio, x.Istio.GetInstallationNamespace()
+	case *v1.Install_Consul:
+		fieldMap[meshType], fieldMap[installationNamespace] = common.Consul, x.Consul.GetInstallationNamespace()
+	case *v1.Install_Linkerd2:
+		fieldMap[meshType], fieldMap[installationNamespace] = common.Linkerd2, x.Linkerd2.GetInstallationNamespace()
+	}
+	// installs are enabled unless disabled explicitly
+	fieldMap[enabled] = strconv.FormatBool(install.Enabled == nil || install.Enabled.Value)
+	fieldMap[chart] = install.GetChartLocator().GetChartPath().GetPath()
+	if mesh, err := meshes.Find(install.Metadata.Namespace, install.Metadata.Name); err == nil {
+		fieldMap[release] = mesh.Metadata.Annotations[constants.HelmReleaseAnnotation]
+	}
+	return fieldMap
+}
@@ -3,48 +3,44 @@ package info
 import (
 	"strconv"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/solo-io/supergloo/cli/pkg/common"
 
-	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/supergloo/pkg/api/v1"
 )
 
 const (
-	meshName   = "NAME"
-	namespace  = "NAMESPACE"
-	target     = "TARGET-MESH"
-	status     = "STATUS"
-	encryption = "ENCRYPTION"
+	meshType              = "TYPE"
+	installationNamespace = "INSTALLATION-NAMESPACE"
+	encryption            = "ENCRYPTION"
 )
 
 // TODO: ideally at some point we might annotate our .proto files with this information
 var meshHeaders = []Header{
-	{Name: meshName, WideOnly: false},
-	{Name: namespace, WideOnly: false},
-	{Name: target, WideOnly: false},
+	{Name: name, WideOnly: false},
+	{Name: meshType, WideOnly: false},
+	{Name: installationNamespace, WideOnly: false},
 	{Name: status, WideOnly: false},
+	{Name: age, WideOnly: false},
 	{Name: encryption, WideOnly: true},
+	{Name: reason, WideOnly: true},
 }
 
-func FromMesh(mesh *v1.Mesh) *ResourceInfo {
-	data := transformMesh(mesh)
-	return &ResourceInfo{headers: meshHeaders, data: []map[string]string{data}}
-}
-
-func FromMeshList(list *v1.MeshList) *ResourceInfo {
+func FromMeshList(list v1.MeshList, created CreationTimestamps) *ResourceInfo {
 	var data Data = make([]map[string]string, 0)
-	for _, mesh := range *list {
-		data = append(data, transformMesh(mesh))
+	var items []proto.Message
+	for _, mesh := range list {
+		data = append(data, transformMesh(mesh, created))
+		items = append(items, mesh)
 	}
-	return &ResourceInfo{headers: meshHeaders, data: data}
+	return &ResourceInfo{headers: meshHeaders, data: data, items: items}
 }
 
-func transformMesh(mesh *v1.Mesh) map[string]string {
-	var meshFieldMap = make(map[string]string, len(meshHeaders))
-	meshFieldMap[meshName] = mesh.Metadata.Name
-	meshFieldMap[target], meshFieldMap[namespace] = getMeshType(mesh)
-	meshFieldMap[status] = core.Status_State_name[int32(mesh.Status.State)]
-	meshFieldMap[encryption] = strconv.FormatBool(mesh.Encryption.TlsEnabled)
+func transformMesh(mesh *v1.Mesh, created CreationTimestamps) map[string]string {
+	meshFieldMap := commonFields(mesh.Metadata, mesh.Status, created)
+	meshFieldMap[meshType], meshFieldMap[installationNamespace] = getMeshType(mesh)
+	meshFieldMap[encryption] = strconv.FormatBool(mesh.GetEncryption().GetTlsEnabled())
 	return meshFieldMap
 }
 
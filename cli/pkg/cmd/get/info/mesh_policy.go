@@ -0,0 +1,50 @@
+package info
+
+import (
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+const (
+	rules     = "RULES"
+	denyRules = "DENY-RULES"
+)
+
+var meshPolicyHeaders = []Header{
+	{Name: name, WideOnly: false},
+	{Name: targetMesh, WideOnly: false},
+	{Name: rules, WideOnly: false},
+	{Name: denyRules, WideOnly: false},
+	{Name: status, WideOnly: false},
+	{Name: age, WideOnly: false},
+	{Name: reason, WideOnly: true},
+}
+
+// the rules themselves are listed along with the ones of the meshes by "get policies"
+func FromMeshPolicyList(list v1.MeshPolicyList, created CreationTimestamps) *ResourceInfo {
+	var data Data = make([]map[string]string, 0)
+	var items []proto.Message
+	for _, meshPolicy := range list {
+		fieldMap := commonFields(meshPolicy.Metadata, meshPolicy.Status, created)
+		fieldMap[targetMesh] = getUpstreams(refs(meshPolicy.TargetMesh))
+		fieldMap[rules] = strconv.Itoa(len(meshPolicy.Rules))
+		fieldMap[denyRules] = strconv.Itoa(len(meshPolicy.DenyRules))
+		data = append(data, fieldMap)
+		items = append(items, meshPolicy)
+	}
+	return &ResourceInfo{headers: meshPolicyHeaders, data: data, items: items}
+}
+
+// the mesh policies targeting the mesh
+func targetingMesh(list v1.MeshPolicyList, mesh core.ResourceRef) v1.MeshPolicyList {
+	var targeting v1.MeshPolicyList
+	for _, meshPolicy := range list {
+		if meshPolicy.TargetMesh != nil && *meshPolicy.TargetMesh == mesh {
+			targeting = append(targeting, meshPolicy)
+		}
+	}
+	return targeting
+}
@@ -1,6 +1,22 @@
 package info
 
-import "github.com/solo-io/supergloo/cli/pkg/cmd/options"
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// columns shared by every resource type
+const (
+	name      = "NAME"
+	namespace = "NAMESPACE"
+	status    = "STATUS"
+	reason    = "REASON"
+	age       = "AGE"
+)
 
 type Header struct {
 	// Name that will be displayed when printing to terminal
@@ -22,11 +38,22 @@ func (h Header) String() string {
 type ResourceInfo struct {
 	headers []Header
 	data    Data
+	// the resources the data was extracted from, for the structured output formats
+	items []proto.Message
+}
+
+// Returns the resources themselves
+func (info ResourceInfo) Items() []proto.Message {
+	return info.items
 }
 
 // Returns a slice containing header names
 func (info ResourceInfo) Headers(opts options.Get) []string {
 	h := make([]string, 0)
+	// as with kubectl, the namespace of the resources is only shown when they are listed from all namespaces
+	if opts.AllNamespaces {
+		h = append(h, namespace)
+	}
 	for _, header := range info.headers {
 		// if this column is wideOnly, include it only if the "-o wide" option was supplied
 		if !header.WideOnly || opts.Output == "wide" {
@@ -57,3 +84,21 @@ func (info ResourceInfo) Resources(opts options.Get) [][]string {
 	}
 	return result
 }
+
+// The time each resource was created in the cluster, which is not part of the solo-kit metadata
+type CreationTimestamps map[core.ResourceRef]time.Time
+
+// the fields of the columns shared by every resource type
+func commonFields(meta core.Metadata, resourceStatus core.Status, created CreationTimestamps) map[string]string {
+	fieldMap := map[string]string{
+		name:      meta.Name,
+		namespace: meta.Namespace,
+		status:    resourceStatus.State.String(),
+		reason:    resourceStatus.Reason,
+		age:       "<unknown>",
+	}
+	if timestamp, ok := created[meta.Ref()]; ok {
+		fieldMap[age] = duration.HumanDuration(time.Since(timestamp))
+	}
+	return fieldMap
+}
@@ -0,0 +1,90 @@
+package info
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+const (
+	mesh        = "MESH"
+	source      = "SOURCE"
+	destination = "DESTINATION"
+	action      = "ACTION"
+	// the mesh or the mesh policy defining the rule
+	definedBy = "DEFINED-BY"
+	// restrictions of the requests allowed by the rules
+	methods      = "METHODS"
+	pathPrefixes = "PATH-PREFIXES"
+	ports        = "PORTS"
+)
+
+var policyHeaders = []Header{
+	{Name: mesh, WideOnly: false},
+	{Name: source, WideOnly: false},
+	{Name: destination, WideOnly: false},
+	{Name: action, WideOnly: false},
+	{Name: definedBy, WideOnly: true},
+	{Name: methods, WideOnly: true},
+	{Name: pathPrefixes, WideOnly: true},
+	{Name: ports, WideOnly: true},
+}
+
+// one row for each rule of the policies of the meshes and of the mesh policies targeting them,
+// which allows or denies the requests from its source to its destination
+func FromPolicies(meshes v1.MeshList, meshPolicies v1.MeshPolicyList) *ResourceInfo {
+	var data Data = make([]map[string]string, 0)
+	var items []proto.Message
+	for _, m := range meshes {
+		addRules := func(rules []*v1.Rule, ruleAction, definedIn string) {
+			for _, rule := range rules {
+				data = append(data, map[string]string{
+					namespace:    m.Metadata.Namespace,
+					mesh:         m.Metadata.Name,
+					source:       getUpstreams(refs(rule.Source)),
+					destination:  getUpstreams(refs(rule.Destination)),
+					action:       ruleAction,
+					definedBy:    definedIn,
+					methods:      orAll(rule.Methods),
+					pathPrefixes: orAll(rule.PathPrefixes),
+					ports:        orAll(portStrings(rule.Ports)),
+				})
+				items = append(items, rule)
+			}
+		}
+		addRules(m.GetPolicy().GetRules(), "allow", "mesh")
+		addRules(m.GetPolicy().GetDenyRules(), "deny", "mesh")
+		for _, meshPolicy := range targetingMesh(meshPolicies, m.Metadata.Ref()) {
+			definedIn := "meshpolicy " + meshPolicy.Metadata.Ref().Key()
+			addRules(meshPolicy.Rules, "allow", definedIn)
+			addRules(meshPolicy.DenyRules, "deny", definedIn)
+		}
+	}
+	return &ResourceInfo{headers: policyHeaders, data: data, items: items}
+}
+
+func refs(ref *core.ResourceRef) []*core.ResourceRef {
+	if ref == nil {
+		return nil
+	}
+	return []*core.ResourceRef{ref}
+}
+
+// the values separated by commas, or "*" if the rule does not restrict them
+func orAll(values []string) string {
+	if len(values) == 0 {
+		return "*"
+	}
+	return strings.Join(values, ",")
+}
+
+func portStrings(ports []uint32) []string {
+	var values []string
+	for _, port := range ports {
+		values = append(values, fmt.Sprint(port))
+	}
+	return values
+}
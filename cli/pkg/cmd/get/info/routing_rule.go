@@ -2,8 +2,10 @@ package info
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/supergloo/cli/pkg/common"
 	glooV1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
@@ -11,7 +13,6 @@ import (
 )
 
 const (
-	name         = "NAME"
 	targetMesh   = "TARGET-MESH"
 	sources      = "SOURCES"
 	destinations = "DESTINATIONS"
@@ -22,34 +23,50 @@ const (
 var routingRuleHeaders = []Header{
 	{Name: name, WideOnly: false},
 	{Name: targetMesh, WideOnly: false},
-	{Name: sources, WideOnly: true},
-	{Name: destinations, WideOnly: true},
+	{Name: sources, WideOnly: false},
+	{Name: destinations, WideOnly: false},
+	{Name: status, WideOnly: false},
+	{Name: age, WideOnly: false},
 	{Name: matchers, WideOnly: true},
+	{Name: reason, WideOnly: true},
 }
 
-func FromRoutingRule(routingRule *v1.RoutingRule) *ResourceInfo {
-	data := transform(routingRule)
-	return &ResourceInfo{headers: routingRuleHeaders, data: []map[string]string{data}}
-}
-
-func FromRoutingRuleList(list *v1.RoutingRuleList) *ResourceInfo {
+func FromRoutingRuleList(list v1.RoutingRuleList, created CreationTimestamps) *ResourceInfo {
 	var data Data = make([]map[string]string, 0)
-	for _, mesh := range *list {
-		data = append(data, transform(mesh))
+	var items []proto.Message
+	for _, rule := range list {
+		data = append(data, transform(rule, created))
+		items = append(items, rule)
 	}
-	return &ResourceInfo{headers: routingRuleHeaders, data: data}
+	return &ResourceInfo{headers: routingRuleHeaders, data: data, items: items}
 }
 
-func transform(routingRule *v1.RoutingRule) map[string]string {
-	var fieldMap = make(map[string]string, len(routingRuleHeaders))
-	fieldMap[name] = routingRule.Metadata.Name
-	fieldMap[targetMesh] = routingRule.TargetMesh.Name
-	fieldMap[sources] = getUpstreams(routingRule.Sources)
-	fieldMap[destinations] = getUpstreams(routingRule.Destinations)
+func transform(routingRule *v1.RoutingRule, created CreationTimestamps) map[string]string {
+	fieldMap := commonFields(routingRule.Metadata, routingRule.Status, created)
+	if routingRule.TargetMesh != nil {
+		fieldMap[targetMesh] = getUpstreams([]*core.ResourceRef{routingRule.TargetMesh})
+	}
+	fieldMap[sources] = getSelectedUpstreams(routingRule.Sources, routingRule.SourceSelector)
+	fieldMap[destinations] = getSelectedUpstreams(routingRule.Destinations, routingRule.DestinationSelector)
 	fieldMap[matchers] = getMatchers(routingRule.RequestMatchers)
 	return fieldMap
 }
 
+// the upstreams and the selector of the rule, or "*" if the rule applies to all of them
+func getSelectedUpstreams(refs []*core.ResourceRef, selector *v1.UpstreamSelector) string {
+	if len(refs) == 0 && selector == nil {
+		return "*"
+	}
+	selected := getUpstreams(refs)
+	if selector != nil {
+		if selected != "" {
+			selected += common.ListOptionSeparator
+		}
+		selected += getSelector(selector)
+	}
+	return selected
+}
+
 func getUpstreams(refs []*core.ResourceRef) string {
 	var b strings.Builder
 	for i, ref := range refs {
@@ -62,25 +79,62 @@ func getUpstreams(refs []*core.ResourceRef) string {
 	return b.String()
 }
 
+// the selector in the form {label=value,namespace=ns|ns,service=ns:name|ns:name}
+func getSelector(selector *v1.UpstreamSelector) string {
+	var clauses []string
+	labels := make([]string, 0, len(selector.Labels))
+	for k, v := range selector.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	clauses = append(clauses, labels...)
+	if len(selector.Namespaces) > 0 {
+		clauses = append(clauses, "namespace="+strings.Join(selector.Namespaces, common.SubListOptionSeparator))
+	}
+	if len(selector.Services) > 0 {
+		services := strings.Replace(getUpstreams(selector.Services), common.ListOptionSeparator, common.SubListOptionSeparator, -1)
+		clauses = append(clauses, "service="+services)
+	}
+	return "{" + strings.Join(clauses, common.ListOptionSeparator) + "}"
+}
+
+// the matchers in the syntax of the --match option of "create routingrule"
 func getMatchers(matchers []*glooV1.Matcher) string {
 	result := make([]string, len(matchers))
 	for i, m := range matchers {
 
 		clauses := make([]string, 0)
-		if m.PathSpecifier != nil {
-			switch specifier := m.PathSpecifier.(type) {
-			case *glooV1.Matcher_Prefix:
-				clauses = append(clauses, fmt.Sprintf("prefix=%s", specifier.Prefix))
-			default:
-				//TODO: ignore path specifiers that we currently don't support
-			}
+		switch specifier := m.PathSpecifier.(type) {
+		case *glooV1.Matcher_Prefix:
+			clauses = append(clauses, fmt.Sprintf("prefix=%s", specifier.Prefix))
+		case *glooV1.Matcher_Exact:
+			clauses = append(clauses, fmt.Sprintf("exact=%s", specifier.Exact))
+		case *glooV1.Matcher_Regex:
+			clauses = append(clauses, fmt.Sprintf("regex=%s", specifier.Regex))
+		}
+
+		for _, header := range m.Headers {
+			clauses = append(clauses, "header:"+nameValue(header.Name, header.Value, header.Regex))
+		}
+		for _, param := range m.QueryParameters {
+			clauses = append(clauses, "query:"+nameValue(param.Name, param.Value, param.Regex))
 		}
 
 		if m.Methods != nil {
-			clauses = append(clauses, fmt.Sprintf("methods=%s", strings.Join(m.Methods, common.SubListOptionSeparator)))
+			clauses = append(clauses, fmt.Sprintf("method=%s", strings.Join(m.Methods, common.SubListOptionSeparator)))
 		}
 
 		result[i] = strings.Join(clauses, common.ListOptionSeparator)
 	}
 	return strings.Join(result, " && ")
 }
+
+func nameValue(name, value string, regex bool) string {
+	switch {
+	case regex:
+		return name + "~=" + value
+	case value != "":
+		return name + "=" + value
+	}
+	return name
+}
@@ -0,0 +1,73 @@
+package info
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	istiosecret "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
+)
+
+const (
+	fingerprint     = "FINGERPRINT"
+	expires         = "EXPIRES"
+	rootFingerprint = "ROOT-FINGERPRINT"
+	rootExpires     = "ROOT-EXPIRES"
+)
+
+var secretHeaders = []Header{
+	{Name: name, WideOnly: false},
+	{Name: fingerprint, WideOnly: false},
+	{Name: expires, WideOnly: false},
+	{Name: age, WideOnly: false},
+	{Name: rootFingerprint, WideOnly: true},
+	{Name: rootExpires, WideOnly: true},
+}
+
+// The secrets are described by the fingerprint and expiry of their certificates. Their private key is never printed,
+// not even by the structured output formats
+func FromSecretList(list istiosecret.IstioCacertsSecretList, created CreationTimestamps, now time.Time) *ResourceInfo {
+	var data Data = make([]map[string]string, 0)
+	var items []proto.Message
+	for _, secret := range list {
+		data = append(data, transformSecret(secret, created, now))
+		withoutKey := *secret
+		withoutKey.CaKey = ""
+		items = append(items, &withoutKey)
+	}
+	return &ResourceInfo{headers: secretHeaders, data: data, items: items}
+}
+
+func transformSecret(secret *istiosecret.IstioCacertsSecret, created CreationTimestamps, now time.Time) map[string]string {
+	fieldMap := commonFields(secret.Metadata, core.Status{}, created)
+	fieldMap[fingerprint], fieldMap[expires] = describeCert(secret.CaCert, now)
+	fieldMap[rootFingerprint], fieldMap[rootExpires] = describeCert(secret.RootCert, now)
+	return fieldMap
+}
+
+// returns the sha256 fingerprint and the expiry of the first certificate of the pem data
+func describeCert(pemData string, now time.Time) (string, string) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return "<none>", ""
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "<invalid>", ""
+	}
+	sum := sha256.Sum256(cert.Raw)
+	hexBytes := make([]string, len(sum))
+	for i, b := range sum {
+		hexBytes[i] = fmt.Sprintf("%02X", b)
+	}
+	expiry := cert.NotAfter.UTC().Format(time.RFC3339)
+	if cert.NotAfter.Before(now) {
+		expiry += " (expired)"
+	}
+	return strings.Join(hexBytes, ":"), expiry
+}
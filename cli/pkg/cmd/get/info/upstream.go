@@ -0,0 +1,87 @@
+package info
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	glooV1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+const (
+	upstreamType = "TYPE"
+	service      = "SERVICE"
+	meshes       = "MESHES"
+)
+
+var upstreamHeaders = []Header{
+	{Name: name, WideOnly: false},
+	{Name: upstreamType, WideOnly: false},
+	{Name: service, WideOnly: false},
+	{Name: meshes, WideOnly: false},
+	{Name: status, WideOnly: false},
+	{Name: age, WideOnly: false},
+	{Name: reason, WideOnly: true},
+}
+
+func FromUpstreamList(list glooV1.UpstreamList, meshList v1.MeshList, created CreationTimestamps) *ResourceInfo {
+	var data Data = make([]map[string]string, 0)
+	var items []proto.Message
+	for _, us := range list {
+		data = append(data, transformUpstream(us, meshList, created))
+		items = append(items, us)
+	}
+	return &ResourceInfo{headers: upstreamHeaders, data: data, items: items}
+}
+
+func transformUpstream(us *glooV1.Upstream, meshList v1.MeshList, created CreationTimestamps) map[string]string {
+	fieldMap := commonFields(us.Metadata, us.Status, created)
+	switch spec := us.GetUpstreamSpec().GetUpstreamType().(type) {
+	case *glooV1.UpstreamSpec_Kube:
+		fieldMap[upstreamType] = "kube"
+		fieldMap[service] = fmt.Sprintf("%v%v%v:%v", spec.Kube.ServiceNamespace, common.NamespacedResourceSeparator,
+			spec.Kube.ServiceName, spec.Kube.ServicePort)
+	case *glooV1.UpstreamSpec_Consul:
+		fieldMap[upstreamType] = "consul"
+		fieldMap[service] = spec.Consul.ServiceName
+	case *glooV1.UpstreamSpec_Static:
+		fieldMap[upstreamType] = "static"
+	case *glooV1.UpstreamSpec_Aws:
+		fieldMap[upstreamType] = "aws"
+	case *glooV1.UpstreamSpec_Azure:
+		fieldMap[upstreamType] = "azure"
+	}
+	var members []string
+	for _, m := range meshList {
+		if inMesh(us, m) {
+			members = append(members, getUpstreams(refs(&core.ResourceRef{Name: m.Metadata.Name, Namespace: m.Metadata.Namespace})))
+		}
+	}
+	fieldMap[meshes] = strings.Join(members, common.ListOptionSeparator)
+	return fieldMap
+}
+
+// kubernetes upstreams belong to the istio and linkerd2 meshes watching the namespace of their service,
+// consul upstreams to the consul meshes
+func inMesh(us *glooV1.Upstream, mesh *v1.Mesh) bool {
+	switch spec := us.GetUpstreamSpec().GetUpstreamType().(type) {
+	case *glooV1.UpstreamSpec_Kube:
+		var watchNamespaces []string
+		switch meshType := mesh.MeshType.(type) {
+		case *v1.Mesh_Istio:
+			watchNamespaces = meshType.Istio.GetWatchNamespaces()
+		case *v1.Mesh_Linkerd2:
+			watchNamespaces = meshType.Linkerd2.GetWatchNamespaces()
+		default:
+			return false
+		}
+		// meshes without watch namespaces watch all of them
+		return len(watchNamespaces) == 0 || common.Contains(watchNamespaces, spec.Kube.ServiceNamespace)
+	case *glooV1.UpstreamSpec_Consul:
+		return mesh.GetConsul() != nil
+	}
+	return false
+}
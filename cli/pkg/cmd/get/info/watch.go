@@ -0,0 +1,104 @@
+package info
+
+import (
+	"context"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/errutils"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// the resource types which can be watched
+var watchableResourceTypes = []string{"meshes", "routingrules"}
+
+// the resources are emitted each time they change, starting with the current ones
+func (client *KubernetesInfoClient) WatchResources(ctx context.Context, resourceType, namespace, resourceName string) (<-chan *ResourceInfo, <-chan error, error) {
+	if namespace == "" && resourceName != "" {
+		return nil, nil, errors.Errorf("a resource cannot be retrieved by name across all namespaces")
+	}
+	switch resourceType {
+	case "meshes":
+		return client.watch(ctx, (*client.meshClient).BaseClient(), namespace, func() (*ResourceInfo, error) {
+			meshes, err := (*client.meshClient).List(namespace, clients.ListOpts{})
+			if err != nil {
+				return nil, err
+			}
+			var meshList superglooV1.MeshList
+			for _, mesh := range meshes {
+				if resourceName == "" || mesh.Metadata.Name == resourceName {
+					meshList = append(meshList, mesh)
+				}
+			}
+			created, err := client.creationTimestamps(superglooV1.MeshCrd, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return FromMeshList(meshList, created), nil
+		})
+	case "routingrules":
+		return client.watch(ctx, (*client.routingRulesClient).BaseClient(), namespace, func() (*ResourceInfo, error) {
+			rules, err := (*client.routingRulesClient).List(namespace, clients.ListOpts{})
+			if err != nil {
+				return nil, err
+			}
+			var rrList superglooV1.RoutingRuleList
+			for _, rule := range rules {
+				if resourceName == "" || rule.Metadata.Name == resourceName {
+					rrList = append(rrList, rule)
+				}
+			}
+			created, err := client.creationTimestamps(superglooV1.RoutingRuleCrd, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return FromRoutingRuleList(rrList, created), nil
+		})
+	default:
+		return nil, nil, errors.Errorf("watching %v is not supported, only %v can be watched", resourceType, watchableResourceTypes)
+	}
+}
+
+// emits the resources returned by list each time the resources of the client change.
+// the client watches a single namespace, the "default" one if empty, but its watch is updated
+// on every change of its shared cache, which holds the resources of all the namespaces.
+// so a single watch is enough to list the resources of all the namespaces again when any of them changes,
+// including the namespaces created after the watch started
+func (client *KubernetesInfoClient) watch(ctx context.Context, rc clients.ResourceClient, namespace string, list func() (*ResourceInfo, error)) (<-chan *ResourceInfo, <-chan error, error) {
+	updates, watchErrs, err := rc.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "starting %v watch", rc.Kind())
+	}
+	errs := make(chan error)
+	go errutils.AggregateErrs(ctx, errs, watchErrs, rc.Kind()+" watch")
+
+	infos := make(chan *ResourceInfo)
+	go func() {
+		defer close(infos)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-updates:
+				if !ok {
+					return
+				}
+				resourceInfo, err := list()
+				if err != nil {
+					select {
+					case <-ctx.Done():
+						return
+					case errs <- err:
+					}
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case infos <- resourceInfo:
+				}
+			}
+		}
+	}()
+	return infos, errs, nil
+}
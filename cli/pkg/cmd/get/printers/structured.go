@@ -0,0 +1,80 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/proto"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	Json       = "json"
+	Yaml       = "yaml"
+	JsonPath   = "jsonpath="
+	GoTemplate = "go-template="
+)
+
+// Returns true if the output format prints the resources themselves rather than a table
+func IsStructured(output string) bool {
+	return output == Json || output == Yaml || strings.HasPrefix(output, JsonPath) || strings.HasPrefix(output, GoTemplate)
+}
+
+// Prints the resources in the given structured output format. As with kubectl, a single resource is printed
+// as an object, and lists of resources as an object of kind "List" with the resources as its items.
+// jsonpath and go-template outputs are evaluated against the json representation of the resources
+func Structured(w io.Writer, output string, resources []proto.Message, list bool) error {
+	var items []interface{}
+	for _, res := range resources {
+		item, err := protoutils.MarshalMap(res)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	var obj interface{}
+	if list {
+		if items == nil {
+			items = []interface{}{}
+		}
+		obj = map[string]interface{}{"kind": "List", "items": items}
+	} else if len(items) == 1 {
+		obj = items[0]
+	}
+
+	switch {
+	case output == Json:
+		data, err := json.MarshalIndent(obj, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case output == Yaml:
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	case strings.HasPrefix(output, JsonPath):
+		parser := jsonpath.New("output").AllowMissingKeys(true)
+		if err := parser.Parse(strings.TrimPrefix(output, JsonPath)); err != nil {
+			return errors.Wrapf(err, "parsing the jsonpath template")
+		}
+		return parser.Execute(w, obj)
+	case strings.HasPrefix(output, GoTemplate):
+		tmpl, err := template.New("output").Parse(strings.TrimPrefix(output, GoTemplate))
+		if err != nil {
+			return errors.Wrapf(err, "parsing the go template")
+		}
+		return tmpl.Execute(w, obj)
+	}
+	return errors.Errorf("%v is not a structured output format", output)
+}
@@ -38,3 +38,19 @@ func (w *TableWriter) WriteLine(line []string) error {
 func (w *TableWriter) Flush() error {
 	return w.writer.Flush()
 }
+
+// Prints the rows as a table with the given headers
+func Table(writer io.Writer, headers []string, rows [][]string) error {
+	return Rows(writer, append([][]string{headers}, rows...))
+}
+
+// Prints the rows as a table without headers, e.g. the rows which changed since the table was printed
+func Rows(writer io.Writer, rows [][]string) error {
+	w := NewTableWriter(writer)
+	for _, row := range rows {
+		if err := w.WriteLine(row); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,178 @@
+package faultinjection
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox/routerule"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/cli/pkg/nsutil"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+const command = "fault-injection"
+
+func Root(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   command,
+		Short: `Stress test your mesh with faults`,
+		Long: `Delay or abort a percentage of the requests sent from a source upstream to a destination upstream.
+Creates a routing rule for the pair, or updates the faults of the existing rule.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			return injectFaults(opts)
+		},
+	}
+	linkFlags(cmd, opts)
+	return cmd
+}
+
+func linkFlags(cmd *cobra.Command, opts *options.Options) {
+	fOp := &(opts.MeshTool).FaultInjection
+	flags := cmd.Flags()
+	flags.StringVar(&fOp.Source.Name, "source.name", "", "name of the upstream sending the requests")
+	flags.StringVar(&fOp.Source.Namespace, "source.namespace", "", "namespace of the upstream sending the requests")
+	flags.StringVar(&fOp.Destination.Name, "destination.name", "", "name of the upstream receiving the requests")
+	flags.StringVar(&fOp.Destination.Namespace, "destination.namespace", "", "namespace of the upstream receiving the requests")
+	flags.Int32Var(&fOp.DelayPercent, "delay.percent", 0, "percentage of requests to delay")
+	flags.DurationVar(&fOp.FixedDelay, "delay.fixed", 0, "duration of the delay")
+	flags.Int32Var(&fOp.AbortPercent, "abort.percent", 0, "percentage of requests to abort")
+	flags.Int32Var(&fOp.HttpStatus, "abort.httpstatus", 0, "http status returned for the aborted requests")
+}
+
+func injectFaults(opts *options.Options) error {
+	// 1. validate/aquire arguments
+	if err := ensureFlags(opts); err != nil {
+		return err
+	}
+	fOp := &(opts.MeshTool).FaultInjection
+	fault, err := faultInjection(fOp)
+	if err != nil {
+		return err
+	}
+
+	// 2. write the faults on the routing rule for the source and destination
+	meshRef := opts.MeshTool.Mesh
+	source, destination := fOp.Source, fOp.Destination
+	rule, err := routerule.Upsert(meshRef, routerule.Name(command, source, destination),
+		[]*core.ResourceRef{&source}, []*core.ResourceRef{&destination},
+		func(rule *superglooV1.RoutingRule) error {
+			if rule.FaultInjection == nil {
+				rule.FaultInjection = &v1alpha3.HTTPFaultInjection{}
+			}
+			if fault.Delay != nil {
+				rule.FaultInjection.Delay = fault.Delay
+			}
+			if fault.Abort != nil {
+				rule.FaultInjection.Abort = fault.Abort
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Updated the faults injected into requests from %v to %v in routing rule %v\n",
+		source.Name, destination.Name, rule.Metadata.Name)
+	return nil
+}
+
+// Ensure that all the needed user-specified values have been provided
+func ensureFlags(opts *options.Options) error {
+	meshRef := &(opts.MeshTool).Mesh
+	if err := nsutil.EnsureMesh(meshRef, opts); err != nil {
+		return err
+	}
+	fOp := &(opts.MeshTool).FaultInjection
+	if err := nsutil.EnsureCommonResource("upstream", "fault injection source", &fOp.Source, opts); err != nil {
+		return err
+	}
+	if err := nsutil.EnsureCommonResource("upstream", "fault injection destination", &fOp.Destination, opts); err != nil {
+		return err
+	}
+
+	if fOp.DelayPercent != 0 || fOp.AbortPercent != 0 {
+		return nil
+	}
+	if opts.Top.Static {
+		return fmt.Errorf("Please specify a delay percentage, an abort percentage, or both")
+	}
+	return chooseFaults(fOp)
+}
+
+func chooseFaults(fOp *options.FaultInjection) error {
+	delay, err := common.ChooseBool("Delay requests?")
+	if err != nil {
+		return err
+	}
+	if delay {
+		if fOp.DelayPercent, err = common.ChooseInt32("Percentage of requests to delay"); err != nil {
+			return err
+		}
+		if fOp.FixedDelay, err = common.ChooseDuration("Duration of the delay (e.g. 5s)"); err != nil {
+			return err
+		}
+	}
+	abort, err := common.ChooseBool("Abort requests?")
+	if err != nil {
+		return err
+	}
+	if abort {
+		if fOp.AbortPercent, err = common.ChooseInt32("Percentage of requests to abort"); err != nil {
+			return err
+		}
+		if fOp.HttpStatus, err = common.ChooseInt32("Http status of the aborted requests"); err != nil {
+			return err
+		}
+	}
+	if !delay && !abort {
+		return fmt.Errorf("No faults to inject")
+	}
+	return nil
+}
+
+// converts the options to the faults of a routing rule, only the faults whose percentage is set are included
+func faultInjection(fOp *options.FaultInjection) (*v1alpha3.HTTPFaultInjection, error) {
+	fault := &v1alpha3.HTTPFaultInjection{}
+	if fOp.DelayPercent == 0 && fOp.FixedDelay != 0 {
+		return nil, fmt.Errorf("Please provide the percentage of requests to delay")
+	}
+	if fOp.AbortPercent == 0 && fOp.HttpStatus != 0 {
+		return nil, fmt.Errorf("Please provide the percentage of requests to abort")
+	}
+	if fOp.DelayPercent != 0 {
+		if err := validatePercent("delay", fOp.DelayPercent); err != nil {
+			return nil, err
+		}
+		if fOp.FixedDelay <= 0 {
+			return nil, fmt.Errorf("Please provide a positive duration for the delay")
+		}
+		fault.Delay = &v1alpha3.HTTPFaultInjection_Delay{
+			Percent:       fOp.DelayPercent,
+			HttpDelayType: &v1alpha3.HTTPFaultInjection_Delay_FixedDelay{FixedDelay: types.DurationProto(fOp.FixedDelay)},
+		}
+	}
+	if fOp.AbortPercent != 0 {
+		if err := validatePercent("abort", fOp.AbortPercent); err != nil {
+			return nil, err
+		}
+		if http.StatusText(int(fOp.HttpStatus)) == "" {
+			return nil, fmt.Errorf("%v is not a valid http status", fOp.HttpStatus)
+		}
+		fault.Abort = &v1alpha3.HTTPFaultInjection_Abort{
+			Percent:   fOp.AbortPercent,
+			ErrorType: &v1alpha3.HTTPFaultInjection_Abort_HttpStatus{HttpStatus: fOp.HttpStatus},
+		}
+	}
+	return fault, nil
+}
+
+func validatePercent(fault string, percent int32) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("%v percentage must be between 0 and 100, got %v", fault, percent)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package faultinjection
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+)
+
+var _ = Describe("Fault injection options", func() {
+	productpage := core.ResourceRef{Name: "default-productpage-9080", Namespace: "gloo-system"}
+	reviews := core.ResourceRef{Name: "default-reviews-9080", Namespace: "gloo-system"}
+	// options of the static mode, for the istio mesh and the productpage and reviews upstreams
+	staticOptions := func(fOp options.FaultInjection) *options.Options {
+		return &options.Options{
+			Top: options.Top{Static: true},
+			MeshTool: options.MeshTool{
+				Mesh:           core.ResourceRef{Name: "istio", Namespace: "supergloo-system"},
+				FaultInjection: fOp,
+			},
+			Cache: options.OptionsCache{
+				Namespaces: []string{"supergloo-system", "gloo-system"},
+				NsResources: options.NsResourceMap{
+					"supergloo-system": {Meshes: []string{"istio"}},
+					"gloo-system":      {Upstreams: []string{productpage.Name, reviews.Name}},
+				},
+			},
+		}
+	}
+	delay := &v1alpha3.HTTPFaultInjection_Delay{
+		Percent:       50,
+		HttpDelayType: &v1alpha3.HTTPFaultInjection_Delay_FixedDelay{FixedDelay: types.DurationProto(time.Second)},
+	}
+	abort := &v1alpha3.HTTPFaultInjection_Abort{
+		Percent:   100,
+		ErrorType: &v1alpha3.HTTPFaultInjection_Abort_HttpStatus{HttpStatus: 503},
+	}
+
+	table.DescribeTable("converts the options to faults",
+		func(fOp options.FaultInjection, expected *v1alpha3.HTTPFaultInjection) {
+			fault, err := faultInjection(&fOp)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fault).To(Equal(expected))
+		},
+		table.Entry("delay", options.FaultInjection{DelayPercent: 50, FixedDelay: time.Second},
+			&v1alpha3.HTTPFaultInjection{Delay: delay}),
+		table.Entry("abort", options.FaultInjection{AbortPercent: 100, HttpStatus: 503},
+			&v1alpha3.HTTPFaultInjection{Abort: abort}),
+		table.Entry("delay and abort", options.FaultInjection{DelayPercent: 50, FixedDelay: time.Second, AbortPercent: 100, HttpStatus: 503},
+			&v1alpha3.HTTPFaultInjection{Delay: delay, Abort: abort}),
+	)
+
+	table.DescribeTable("rejects invalid faults",
+		func(fOp options.FaultInjection, expectedErr string) {
+			_, err := faultInjection(&fOp)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		table.Entry("negative delay percentage", options.FaultInjection{DelayPercent: -1, FixedDelay: time.Second},
+			"delay percentage must be between 0 and 100, got -1"),
+		table.Entry("delay percentage over 100", options.FaultInjection{DelayPercent: 101, FixedDelay: time.Second},
+			"delay percentage must be between 0 and 100, got 101"),
+		table.Entry("abort percentage over 100", options.FaultInjection{AbortPercent: 101, HttpStatus: 503},
+			"abort percentage must be between 0 and 100, got 101"),
+		table.Entry("delay without duration", options.FaultInjection{DelayPercent: 50},
+			"Please provide a positive duration for the delay"),
+		table.Entry("delay duration without percentage", options.FaultInjection{FixedDelay: time.Second},
+			"Please provide the percentage of requests to delay"),
+		table.Entry("abort without http status", options.FaultInjection{AbortPercent: 50},
+			"0 is not a valid http status"),
+		table.Entry("abort with an invalid http status", options.FaultInjection{AbortPercent: 50, HttpStatus: 999},
+			"999 is not a valid http status"),
+		table.Entry("abort http status without percentage", options.FaultInjection{HttpStatus: 503},
+			"Please provide the percentage of requests to abort"),
+	)
+
+	It("accepts the source, destination and faults of the flags", func() {
+		err := ensureFlags(staticOptions(options.FaultInjection{
+			Source:       productpage,
+			Destination:  reviews,
+			AbortPercent: 100,
+			HttpStatus:   503,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	table.DescribeTable("rejects missing flags",
+		func(fOp options.FaultInjection, expectedErr string) {
+			err := ensureFlags(staticOptions(fOp))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		table.Entry("missing source", options.FaultInjection{Destination: reviews, AbortPercent: 100},
+			"Please provide a fault injection source name"),
+		table.Entry("missing destination namespace",
+			options.FaultInjection{Source: productpage, Destination: core.ResourceRef{Name: reviews.Name}, AbortPercent: 100},
+			"Please provide a fault injection destination namespace"),
+		table.Entry("unknown destination",
+			options.FaultInjection{Source: productpage, Destination: core.ResourceRef{Name: "details", Namespace: "gloo-system"}, AbortPercent: 100},
+			"Please specify a valid details name"),
+		table.Entry("no fault percentages", options.FaultInjection{Source: productpage, Destination: reviews},
+			"Please specify a delay percentage, an abort percentage, or both"),
+	)
+})
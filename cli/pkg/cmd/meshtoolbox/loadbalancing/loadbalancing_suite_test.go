@@ -0,0 +1,13 @@
+package loadbalancing_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLoadbalancing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Loadbalancing Suite")
+}
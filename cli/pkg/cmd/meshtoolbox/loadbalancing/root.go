@@ -0,0 +1,285 @@
+package loadbalancing
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox/routerule"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/cli/pkg/nsutil"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/spf13/cobra"
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+const command = "load-balancing"
+
+var validAlgorithms = []string{
+	v1alpha3.LoadBalancerSettings_ROUND_ROBIN.String(),
+	v1alpha3.LoadBalancerSettings_LEAST_CONN.String(),
+	v1alpha3.LoadBalancerSettings_RANDOM.String(),
+	v1alpha3.LoadBalancerSettings_PASSTHROUGH.String(),
+}
+
+func Root(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   command,
+		Short: `Specify traffic distribution`,
+		Long: `Configure the load balancing algorithm, connection pool limits and outlier detection (circuit breaking)
+for a service (--serviceid), or for every service in the mesh when no service is given.
+Settings which are not specified are left unchanged.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			return configureLoadBalancing(opts)
+		},
+	}
+	linkFlags(cmd, opts)
+	return cmd
+}
+
+func linkFlags(cmd *cobra.Command, opts *options.Options) {
+	lb := &(opts.MeshTool).LoadBalancing
+	flags := cmd.Flags()
+	flags.StringVar(&lb.Algorithm, "algorithm", "", fmt.Sprintf("load balancing algorithm, one of %v", strings.Join(validAlgorithms, ", ")))
+	flags.StringVar(&lb.HashHeader, "hash.header", "", "use consistent hashing on the value of this http header")
+	flags.StringVar(&lb.HashCookie, "hash.cookie", "", "use consistent hashing on the value of this http cookie")
+	flags.DurationVar(&lb.HashCookieTtl, "hash.cookiettl", 0, "lifetime of the hash cookie, which is generated if it is not present (required with --hash.cookie)")
+	flags.BoolVar(&lb.HashSourceIp, "hash.sourceip", false, "use consistent hashing on the source ip address")
+
+	flags.Int32Var(&lb.MaxConnections, "pool.maxconnections", 0, "maximum number of tcp connections to each instance")
+	flags.DurationVar(&lb.ConnectTimeout, "pool.connecttimeout", 0, "tcp connection timeout")
+	flags.Int32Var(&lb.MaxPendingRequests, "pool.maxpendingrequests", 0, "maximum number of pending http requests to each instance")
+	flags.Int32Var(&lb.MaxRequests, "pool.maxrequests", 0, "maximum number of http2 requests to each instance")
+	flags.Int32Var(&lb.MaxRequestsPerConnection, "pool.maxrequestsperconnection", 0, "maximum number of http requests per connection")
+	flags.Int32Var(&lb.MaxRetries, "pool.maxretries", 0, "maximum number of outstanding retries to each instance")
+
+	flags.Int32Var(&lb.ConsecutiveErrors, "outlier.consecutiveerrors", 0, "number of consecutive errors before an instance is ejected")
+	flags.DurationVar(&lb.Interval, "outlier.interval", 0, "time between ejection sweeps")
+	flags.DurationVar(&lb.BaseEjectionTime, "outlier.baseejectiontime", 0, "minimum ejection duration, multiplied by the number of times an instance has been ejected")
+	flags.Int32Var(&lb.MaxEjectionPercent, "outlier.maxejectionpercent", 0, "maximum percentage of instances that can be ejected")
+}
+
+func configureLoadBalancing(opts *options.Options) error {
+	// 1. validate/aquire arguments
+	policy, upstream, err := ensureFlags(opts)
+	if err != nil {
+		return err
+	}
+
+	// 2. write the traffic policy on the mesh, or on the routing rule for the service
+	meshRef := opts.MeshTool.Mesh
+	if upstream == nil {
+		if err := updateMesh(meshRef, policy); err != nil {
+			return err
+		}
+		fmt.Printf("Updated the traffic policy of mesh %v\n", meshRef.Name)
+		return nil
+	}
+	rule, err := routerule.Upsert(meshRef, routerule.Name(command, *upstream), nil, []*core.ResourceRef{upstream},
+		func(rule *superglooV1.RoutingRule) error {
+			rule.TrafficPolicy = mergeTrafficPolicy(rule.TrafficPolicy, policy)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Updated the traffic policy of %v in routing rule %v\n", opts.MeshTool.ServiceId, rule.Metadata.Name)
+	return nil
+}
+
+// Ensure that all the needed user-specified values have been provided.
+// Returns the traffic policy to apply and the upstream of the service it applies to, nil if it applies to the whole mesh
+func ensureFlags(opts *options.Options) (*superglooV1.TrafficPolicy, *core.ResourceRef, error) {
+	meshRef := &(opts.MeshTool).Mesh
+	if err := nsutil.EnsureMesh(meshRef, opts); err != nil {
+		return nil, nil, err
+	}
+	policy, err := trafficPolicy(&(opts.MeshTool).LoadBalancing)
+	if err != nil {
+		return nil, nil, err
+	}
+	if policy.Equal(&superglooV1.TrafficPolicy{}) {
+		if opts.Top.Static {
+			return nil, nil, fmt.Errorf("Please specify at least one load balancing, connection pool or outlier detection setting")
+		}
+		if err := chooseAlgorithm(&(opts.MeshTool).LoadBalancing); err != nil {
+			return nil, nil, err
+		}
+		if policy, err = trafficPolicy(&(opts.MeshTool).LoadBalancing); err != nil {
+			return nil, nil, err
+		}
+	}
+	meshWide := opts.MeshTool.ServiceId == ""
+	if meshWide && !opts.Top.Static {
+		if meshWide, err = common.ChooseBool("Apply these settings to every service in the mesh?"); err != nil {
+			return nil, nil, err
+		}
+	}
+	if meshWide {
+		return policy, nil, nil
+	}
+	upstream, err := routerule.EnsureServiceId(command, "destination upstream", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return policy, &upstream, nil
+}
+
+func updateMesh(meshRef core.ResourceRef, policy *superglooV1.TrafficPolicy) error {
+	meshClient, err := common.GetMeshClient()
+	if err != nil {
+		return err
+	}
+	mesh, err := (*meshClient).Read(meshRef.Namespace, meshRef.Name, clients.ReadOpts{})
+	if err != nil {
+		return err
+	}
+	mesh.TrafficPolicy = mergeTrafficPolicy(mesh.TrafficPolicy, policy)
+	_, err = (*meshClient).Write(mesh, clients.WriteOpts{OverwriteExisting: true})
+	return err
+}
+
+// the settings of update replace those of the existing policy, settings it does not specify are kept
+func mergeTrafficPolicy(existing, update *superglooV1.TrafficPolicy) *superglooV1.TrafficPolicy {
+	if existing == nil {
+		return update
+	}
+	merged := proto.Clone(existing).(*superglooV1.TrafficPolicy)
+	if update.LoadBalancer != nil {
+		// the algorithm and the hash key are exclusive, so the load balancer is replaced rather than merged
+		merged.LoadBalancer = update.LoadBalancer
+	}
+	if update.ConnectionPool != nil {
+		if merged.ConnectionPool == nil {
+			merged.ConnectionPool = &v1alpha3.ConnectionPoolSettings{}
+		}
+		proto.Merge(merged.ConnectionPool, update.ConnectionPool)
+	}
+	if update.OutlierDetection != nil {
+		if merged.OutlierDetection == nil {
+			merged.OutlierDetection = &v1alpha3.OutlierDetection{}
+		}
+		proto.Merge(merged.OutlierDetection, update.OutlierDetection)
+	}
+	return merged
+}
+
+func chooseAlgorithm(lb *options.LoadBalancing) error {
+	question := &survey.Select{
+		Message: "Select a load balancing algorithm",
+		Options: validAlgorithms,
+	}
+	if err := survey.AskOne(question, &lb.Algorithm, survey.Required); err != nil {
+		// this should not error
+		fmt.Println("error with input")
+		return err
+	}
+	return nil
+}
+
+// converts the options to a traffic policy, only the settings that were specified are set
+func trafficPolicy(lb *options.LoadBalancing) (*superglooV1.TrafficPolicy, error) {
+	policy := &superglooV1.TrafficPolicy{}
+
+	loadBalancer, err := loadBalancerSettings(lb)
+	if err != nil {
+		return nil, err
+	}
+	policy.LoadBalancer = loadBalancer
+
+	tcp := &v1alpha3.ConnectionPoolSettings_TCPSettings{
+		MaxConnections: lb.MaxConnections,
+		ConnectTimeout: durationProto(lb.ConnectTimeout),
+	}
+	http := &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+		Http1MaxPendingRequests:  lb.MaxPendingRequests,
+		Http2MaxRequests:         lb.MaxRequests,
+		MaxRequestsPerConnection: lb.MaxRequestsPerConnection,
+		MaxRetries:               lb.MaxRetries,
+	}
+	if !tcp.Equal(&v1alpha3.ConnectionPoolSettings_TCPSettings{}) || !http.Equal(&v1alpha3.ConnectionPoolSettings_HTTPSettings{}) {
+		policy.ConnectionPool = &v1alpha3.ConnectionPoolSettings{}
+		if !tcp.Equal(&v1alpha3.ConnectionPoolSettings_TCPSettings{}) {
+			policy.ConnectionPool.Tcp = tcp
+		}
+		if !http.Equal(&v1alpha3.ConnectionPoolSettings_HTTPSettings{}) {
+			policy.ConnectionPool.Http = http
+		}
+	}
+
+	if lb.MaxEjectionPercent < 0 || lb.MaxEjectionPercent > 100 {
+		return nil, fmt.Errorf("max ejection percent must be between 0 and 100, got %v", lb.MaxEjectionPercent)
+	}
+	outlierDetection := &v1alpha3.OutlierDetection{
+		ConsecutiveErrors:  lb.ConsecutiveErrors,
+		Interval:           durationProto(lb.Interval),
+		BaseEjectionTime:   durationProto(lb.BaseEjectionTime),
+		MaxEjectionPercent: lb.MaxEjectionPercent,
+	}
+	if !outlierDetection.Equal(&v1alpha3.OutlierDetection{}) {
+		policy.OutlierDetection = outlierDetection
+	}
+	return policy, nil
+}
+
+func loadBalancerSettings(lb *options.LoadBalancing) (*v1alpha3.LoadBalancerSettings, error) {
+	var hashKeys []*v1alpha3.LoadBalancerSettings_ConsistentHashLB
+	if lb.HashHeader != "" {
+		hashKeys = append(hashKeys, &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+			HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{HttpHeaderName: lb.HashHeader},
+		})
+	}
+	if lb.HashCookie != "" {
+		if lb.HashCookieTtl == 0 {
+			return nil, fmt.Errorf("Please provide a ttl for the hash cookie")
+		}
+		ttl := lb.HashCookieTtl
+		hashKeys = append(hashKeys, &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+			HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+				HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+					Name: lb.HashCookie,
+					Ttl:  &ttl,
+				},
+			},
+		})
+	}
+	if lb.HashSourceIp {
+		hashKeys = append(hashKeys, &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+			HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{UseSourceIp: true},
+		})
+	}
+
+	switch {
+	case len(hashKeys) > 1:
+		return nil, fmt.Errorf("Please specify at most one of --hash.header, --hash.cookie and --hash.sourceip")
+	case len(hashKeys) == 1 && lb.Algorithm != "":
+		return nil, fmt.Errorf("An algorithm cannot be combined with consistent hashing")
+	case len(hashKeys) == 1:
+		return &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: hashKeys[0]},
+		}, nil
+	case lb.Algorithm != "":
+		algorithm, ok := v1alpha3.LoadBalancerSettings_SimpleLB_value[strings.ToUpper(lb.Algorithm)]
+		if !ok {
+			return nil, fmt.Errorf("%v is not a valid algorithm, must be one of %v", lb.Algorithm, strings.Join(validAlgorithms, ", "))
+		}
+		return &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_SimpleLB(algorithm)},
+		}, nil
+	}
+	return nil, nil
+}
+
+// unset durations are left out of the policy
+func durationProto(d time.Duration) *types.Duration {
+	if d == 0 {
+		return nil
+	}
+	return types.DurationProto(d)
+}
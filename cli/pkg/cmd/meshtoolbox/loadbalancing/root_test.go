@@ -0,0 +1,122 @@
+package loadbalancing
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+var _ = Describe("Load balancing options", func() {
+	// options of the static mode, for the istio mesh and the reviews upstream
+	staticOptions := func(serviceId string, lb options.LoadBalancing) *options.Options {
+		return &options.Options{
+			Top: options.Top{Static: true},
+			MeshTool: options.MeshTool{
+				Mesh:          core.ResourceRef{Name: "istio", Namespace: "supergloo-system"},
+				ServiceId:     serviceId,
+				LoadBalancing: lb,
+			},
+			Cache: options.OptionsCache{
+				Namespaces: []string{"supergloo-system", "gloo-system"},
+				NsResources: options.NsResourceMap{
+					"supergloo-system": {Meshes: []string{"istio"}},
+					"gloo-system":      {Upstreams: []string{"default-reviews-9080"}},
+				},
+			},
+		}
+	}
+
+	table.DescribeTable("converts the options to a traffic policy",
+		func(lb options.LoadBalancing, expected *superglooV1.TrafficPolicy) {
+			policy, err := trafficPolicy(&lb)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(Equal(expected))
+		},
+		table.Entry("no settings", options.LoadBalancing{}, &superglooV1.TrafficPolicy{}),
+		table.Entry("algorithm in any case", options.LoadBalancing{Algorithm: "least_conn"},
+			&superglooV1.TrafficPolicy{LoadBalancer: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_LEAST_CONN},
+			}}),
+		table.Entry("hash cookie", options.LoadBalancing{HashCookie: "user", HashCookieTtl: time.Minute},
+			&superglooV1.TrafficPolicy{LoadBalancer: &v1alpha3.LoadBalancerSettings{
+				LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+						HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{Name: "user", Ttl: durationPtr(time.Minute)},
+					},
+				}},
+			}}),
+		table.Entry("connection pool", options.LoadBalancing{MaxConnections: 10, MaxRetries: 3},
+			&superglooV1.TrafficPolicy{ConnectionPool: &v1alpha3.ConnectionPoolSettings{
+				Tcp:  &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+				Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{MaxRetries: 3},
+			}}),
+		table.Entry("outlier detection at the maximum ejection percentage",
+			options.LoadBalancing{ConsecutiveErrors: 5, Interval: time.Second, MaxEjectionPercent: 100},
+			&superglooV1.TrafficPolicy{OutlierDetection: &v1alpha3.OutlierDetection{
+				ConsecutiveErrors:  5,
+				Interval:           types.DurationProto(time.Second),
+				MaxEjectionPercent: 100,
+			}}),
+	)
+
+	table.DescribeTable("rejects invalid settings",
+		func(lb options.LoadBalancing, expectedErr string) {
+			_, err := trafficPolicy(&lb)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		table.Entry("negative ejection percentage", options.LoadBalancing{MaxEjectionPercent: -1},
+			"max ejection percent must be between 0 and 100, got -1"),
+		table.Entry("ejection percentage over 100", options.LoadBalancing{MaxEjectionPercent: 101},
+			"max ejection percent must be between 0 and 100, got 101"),
+		table.Entry("unknown algorithm", options.LoadBalancing{Algorithm: "fastest"},
+			"fastest is not a valid algorithm"),
+		table.Entry("two hash keys", options.LoadBalancing{HashHeader: "x-user", HashSourceIp: true},
+			"Please specify at most one of --hash.header, --hash.cookie and --hash.sourceip"),
+		table.Entry("algorithm with consistent hashing", options.LoadBalancing{Algorithm: "RANDOM", HashHeader: "x-user"},
+			"An algorithm cannot be combined with consistent hashing"),
+		table.Entry("hash cookie without ttl", options.LoadBalancing{HashCookie: "user"},
+			"Please provide a ttl for the hash cookie"),
+	)
+
+	table.DescribeTable("applies the settings to the mesh or to the given service",
+		func(serviceId string, expected *core.ResourceRef) {
+			policy, upstream, err := ensureFlags(staticOptions(serviceId, options.LoadBalancing{Algorithm: "RANDOM"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.LoadBalancer).NotTo(BeNil())
+			Expect(upstream).To(Equal(expected))
+		},
+		table.Entry("mesh-wide without a service id", "", nil),
+		table.Entry("service id", "gloo-system:default-reviews-9080",
+			&core.ResourceRef{Name: "default-reviews-9080", Namespace: "gloo-system"}),
+	)
+
+	table.DescribeTable("rejects invalid flags",
+		func(serviceId string, lb options.LoadBalancing, expectedErr string) {
+			_, _, err := ensureFlags(staticOptions(serviceId, lb))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		table.Entry("no settings", "", options.LoadBalancing{},
+			"Please specify at least one load balancing, connection pool or outlier detection setting"),
+		table.Entry("invalid settings", "", options.LoadBalancing{MaxEjectionPercent: 200},
+			"max ejection percent must be between 0 and 100"),
+		table.Entry("service id without namespace", "default-reviews-9080", options.LoadBalancing{Algorithm: "RANDOM"},
+			"invalid format for option: default-reviews-9080"),
+		table.Entry("service id without name", "gloo-system:", options.LoadBalancing{Algorithm: "RANDOM"},
+			"Please provide a destination upstream name"),
+		table.Entry("unknown service", "gloo-system:details", options.LoadBalancing{Algorithm: "RANDOM"},
+			"Please specify a valid details name"),
+	)
+})
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
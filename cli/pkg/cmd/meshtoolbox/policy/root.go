@@ -74,6 +74,11 @@ func LinkPolicyFlags(cmd *cobra.Command, opts *options.Options) {
 	pflags.StringVar(&dOp.Namespace, "source.namespace", "", "namespace of policy source upstream")
 	pflags.StringVar(&sOp.Name, "destination.name", "", "name of policy destination upstream")
 	pflags.StringVar(&sOp.Namespace, "destination.namespace", "", "namespace of policy destination upstream")
+	pOp := &opts.MeshTool.AddPolicy
+	pflags.StringSliceVar(&pOp.Methods, "methods", nil, "http methods the source may use, all of them if empty")
+	pflags.StringSliceVar(&pOp.PathPrefixes, "path-prefixes", nil, "path prefixes the source may request, all paths if empty")
+	pflags.UintSliceVar(&pOp.Ports, "ports", nil, "destination ports the source may connect to, all ports if empty")
+	pflags.BoolVar(&pOp.Deny, "deny", false, "deny the requests rather than allowing them, even if other rules allow them")
 }
 
 func addPolicy(opts *options.Options) error {
@@ -160,15 +165,20 @@ func updatePolicy(operation string, opts *options.Options) error {
 				Name:      dOp.Name,
 				Namespace: dOp.Namespace,
 			},
+			Methods:      opts.MeshTool.AddPolicy.Methods,
+			PathPrefixes: opts.MeshTool.AddPolicy.PathPrefixes,
+		}
+		for _, port := range opts.MeshTool.AddPolicy.Ports {
+			newRule.Ports = append(newRule.Ports, uint32(port))
 		}
 
 		if mesh.Policy == nil {
-			mesh.Policy = &superglooV1.Policy{
-				Rules: []*superglooV1.Rule{newRule},
-			}
+			mesh.Policy = &superglooV1.Policy{}
+		}
+		if opts.MeshTool.AddPolicy.Deny {
+			mesh.Policy.DenyRules = append(mesh.Policy.DenyRules, newRule)
 		} else {
 			mesh.Policy.Rules = append(mesh.Policy.Rules, newRule)
-
 		}
 	case REMOVE_POLICY:
 		// if there are no rules to begin with, we have nothing to do
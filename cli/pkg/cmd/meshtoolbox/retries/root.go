@@ -0,0 +1,107 @@
+package retries
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox/routerule"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/cli/pkg/nsutil"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+const command = "retries"
+
+func Root(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   command,
+		Short: `Configure retry parameters`,
+		Long: `Configure the retries and the timeout of the requests sent to a service (--serviceid).
+Creates a routing rule for the service, or updates the settings of the existing rule.
+Settings which are not specified are left unchanged.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			return configureRetries(opts)
+		},
+	}
+	linkFlags(cmd, opts)
+	return cmd
+}
+
+func linkFlags(cmd *cobra.Command, opts *options.Options) {
+	rOp := &(opts.MeshTool).Retries
+	flags := cmd.Flags()
+	flags.Int32Var(&rOp.Attempts, "attempts", 0, "number of retries for a request")
+	flags.DurationVar(&rOp.PerTryTimeout, "pertrytimeout", 0, "timeout of each attempt")
+	flags.DurationVar(&rOp.Timeout, "timeout", 0, "timeout of a request, including all its retries")
+}
+
+func configureRetries(opts *options.Options) error {
+	// 1. validate/aquire arguments
+	if err := ensureFlags(opts); err != nil {
+		return err
+	}
+	upstream, err := routerule.EnsureServiceId(command, "destination upstream", opts)
+	if err != nil {
+		return err
+	}
+
+	// 2. write the settings on the routing rule for the service
+	rOp := &(opts.MeshTool).Retries
+	rule, err := routerule.Upsert(opts.MeshTool.Mesh, routerule.Name(command, upstream), nil, []*core.ResourceRef{&upstream},
+		func(rule *superglooV1.RoutingRule) error {
+			if rOp.Attempts != 0 || rOp.PerTryTimeout != 0 {
+				if rule.Retries == nil {
+					rule.Retries = &v1alpha3.HTTPRetry{}
+				}
+				if rOp.Attempts != 0 {
+					rule.Retries.Attempts = rOp.Attempts
+				}
+				if rOp.PerTryTimeout != 0 {
+					rule.Retries.PerTryTimeout = types.DurationProto(rOp.PerTryTimeout)
+				}
+			}
+			if rOp.Timeout != 0 {
+				rule.Timeout = types.DurationProto(rOp.Timeout)
+			}
+			// the existing settings of the rule count as well
+			return routerule.ValidateRetries(rule.Retries, rule.Timeout)
+		})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Updated the retries of %v in routing rule %v\n", opts.MeshTool.ServiceId, rule.Metadata.Name)
+	return nil
+}
+
+// Ensure that all the needed user-specified values have been provided
+func ensureFlags(opts *options.Options) error {
+	meshRef := &(opts.MeshTool).Mesh
+	if err := nsutil.EnsureMesh(meshRef, opts); err != nil {
+		return err
+	}
+	rOp := &(opts.MeshTool).Retries
+	if rOp.Attempts < 0 || rOp.PerTryTimeout < 0 || rOp.Timeout < 0 {
+		return fmt.Errorf("Retry attempts and timeouts cannot be negative")
+	}
+	if rOp.Attempts != 0 || rOp.PerTryTimeout != 0 || rOp.Timeout != 0 {
+		return nil
+	}
+	if opts.Top.Static {
+		return fmt.Errorf("Please specify the retry attempts, the per-try timeout or the timeout")
+	}
+	var err error
+	if rOp.Attempts, err = common.ChooseInt32("Number of retries"); err != nil {
+		return err
+	}
+	if rOp.PerTryTimeout, err = common.ChooseDuration("Timeout of each attempt (e.g. 2s)"); err != nil {
+		return err
+	}
+	if rOp.Timeout, err = common.ChooseDuration("Timeout of a request, including all its retries (e.g. 10s)"); err != nil {
+		return err
+	}
+	return nil
+}
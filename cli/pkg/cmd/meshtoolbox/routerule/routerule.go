@@ -0,0 +1,96 @@
+package routerule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/cli/pkg/nsutil"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// EnsureServiceId validates the upstream given by --serviceid, in the form <namespace>:<name>.
+// If in interactive mode (non-static mode) and no service is given, it will prompt the user to choose one
+// command is the name of the command being run, for the help message
+func EnsureServiceId(command, menuDescription string, opts *options.Options) (core.ResourceRef, error) {
+	var ref core.ResourceRef
+	if id := opts.MeshTool.ServiceId; id != "" {
+		parts := strings.Split(id, common.NamespacedResourceSeparator)
+		if len(parts) != 2 {
+			return ref, fmt.Errorf(common.InvalidOptionFormat, id, command)
+		}
+		ref.Namespace, ref.Name = parts[0], parts[1]
+	}
+	if err := nsutil.EnsureCommonResource("upstream", menuDescription, &ref, opts); err != nil {
+		return ref, err
+	}
+	opts.MeshTool.ServiceId = ref.Namespace + common.NamespacedResourceSeparator + ref.Name
+	return ref, nil
+}
+
+// Name returns the name of the routing rule that the mesh tools manage for the given feature and upstreams
+func Name(feature string, upstreams ...core.ResourceRef) string {
+	name := feature
+	for _, us := range upstreams {
+		name += "-" + us.Name
+	}
+	return name
+}
+
+// Upsert reads the routing rule with the given name in the namespace of the mesh, or creates
+// a new one for the given sources and destinations if it does not exist yet,
+// applies update to it and writes it back
+func Upsert(meshRef core.ResourceRef, name string, sources, destinations []*core.ResourceRef, update func(rule *superglooV1.RoutingRule) error) (*superglooV1.RoutingRule, error) {
+	rrClient, err := common.GetRoutingRuleClient()
+	if err != nil {
+		return nil, err
+	}
+	rule, err := (*rrClient).Read(meshRef.Namespace, name, clients.ReadOpts{})
+	if err != nil {
+		if !errors.IsNotExist(err) {
+			return nil, err
+		}
+		rule = &superglooV1.RoutingRule{
+			Metadata: core.Metadata{
+				Name:      name,
+				Namespace: meshRef.Namespace,
+			},
+			TargetMesh:   &meshRef,
+			Sources:      sources,
+			Destinations: destinations,
+		}
+	}
+	if err := update(rule); err != nil {
+		return nil, err
+	}
+	return (*rrClient).Write(rule, clients.WriteOpts{OverwriteExisting: true})
+}
+
+// ValidateRetries checks that the first try of a request and all its retries fit within its timeout, otherwise the last retries can never complete.
+// the attempts are the number of retries, so a request is tried up to attempts+1 times
+func ValidateRetries(retries *v1alpha3.HTTPRetry, timeout *types.Duration) error {
+	if retries == nil || retries.PerTryTimeout == nil || timeout == nil {
+		return nil
+	}
+	perTryTimeout, err := types.DurationFromProto(retries.PerTryTimeout)
+	if err != nil {
+		return err
+	}
+	requestTimeout, err := types.DurationFromProto(timeout)
+	if err != nil {
+		return err
+	}
+	if total := perTryTimeout * time.Duration(retries.Attempts+1); total > requestTimeout {
+		return fmt.Errorf("the first try and %v retries with a per-try timeout of %v take up to %v, which exceeds the request timeout of %v",
+			retries.Attempts, perTryTimeout, total, requestTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package routerule_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouterule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Routerule Suite")
+}
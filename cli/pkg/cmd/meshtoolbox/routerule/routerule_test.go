@@ -0,0 +1,43 @@
+package routerule_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox/routerule"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+)
+
+var _ = Describe("ValidateRetries", func() {
+	retries := func(attempts int32, perTryTimeout time.Duration) *v1alpha3.HTTPRetry {
+		return &v1alpha3.HTTPRetry{Attempts: attempts, PerTryTimeout: types.DurationProto(perTryTimeout)}
+	}
+
+	table.DescribeTable("accepts retries fitting within the request timeout",
+		func(retries *v1alpha3.HTTPRetry, timeout *types.Duration) {
+			Expect(ValidateRetries(retries, timeout)).NotTo(HaveOccurred())
+		},
+		table.Entry("no retries", nil, types.DurationProto(time.Second)),
+		table.Entry("no request timeout", retries(3, time.Second), nil),
+		table.Entry("zero attempts", retries(0, time.Second), types.DurationProto(time.Second)),
+		table.Entry("missing per-try timeout", &v1alpha3.HTTPRetry{Attempts: 3}, types.DurationProto(time.Second)),
+		table.Entry("the first try and the retries exactly filling the timeout", retries(3, time.Second), types.DurationProto(4*time.Second)),
+	)
+
+	table.DescribeTable("rejects retries exceeding the request timeout",
+		func(retries *v1alpha3.HTTPRetry, timeout *types.Duration, expectedErr string) {
+			err := ValidateRetries(retries, timeout)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(expectedErr))
+		},
+		table.Entry("zero attempts with a per-try timeout over the timeout", retries(0, 2*time.Second), types.DurationProto(time.Second),
+			"the first try and 0 retries with a per-try timeout of 2s take up to 2s, which exceeds the request timeout of 1s"),
+		table.Entry("the retries filling the timeout without the first try", retries(3, time.Second), types.DurationProto(3*time.Second),
+			"the first try and 3 retries with a per-try timeout of 1s take up to 4s, which exceeds the request timeout of 3s"),
+		table.Entry("over the timeout", retries(5, time.Second), types.DurationProto(4*time.Second),
+			"the first try and 5 retries with a per-try timeout of 1s take up to 6s, which exceeds the request timeout of 4s"),
+	)
+})
@@ -1,6 +1,8 @@
 package options
 
 import (
+	"time"
+
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"k8s.io/client-go/kubernetes"
 )
@@ -13,6 +15,8 @@ type Options struct {
 	IngressTool IngressTool
 	Get         Get
 	Create      Create
+	Preview     Preview
+	Describe    Describe
 	Config      Config
 	Cache       OptionsCache
 }
@@ -41,9 +45,12 @@ type Uninstall struct {
 }
 
 type MeshTool struct {
-	Mesh      core.ResourceRef
-	ServiceId string
-	AddPolicy AddPolicy
+	Mesh           core.ResourceRef
+	ServiceId      string
+	AddPolicy      AddPolicy
+	LoadBalancing  LoadBalancing
+	FaultInjection FaultInjection
+	Retries        Retries
 }
 
 type AddPolicy struct {
@@ -54,6 +61,59 @@ type AddPolicy struct {
 
 	Source      core.ResourceRef
 	Destination core.ResourceRef
+	// optional restrictions of the requests allowed by the rule, none means all of them are allowed
+	Methods      []string
+	PathPrefixes []string
+	Ports        []uint
+	// adds the rule to the deny rules of the policy
+	Deny bool
+}
+
+// settings of the traffic policy applied by the load-balancing command, unset (zero) values are left unchanged
+type LoadBalancing struct {
+	// one of ROUND_ROBIN, LEAST_CONN, RANDOM or PASSTHROUGH
+	Algorithm string
+
+	// consistent hashing, at most one hash key can be set and it cannot be combined with an algorithm
+	HashHeader    string
+	HashCookie    string
+	HashCookieTtl time.Duration
+	HashSourceIp  bool
+
+	// connection pool
+	MaxConnections           int32
+	ConnectTimeout           time.Duration
+	MaxPendingRequests       int32
+	MaxRequests              int32
+	MaxRequestsPerConnection int32
+	MaxRetries               int32
+
+	// outlier detection
+	ConsecutiveErrors  int32
+	Interval           time.Duration
+	BaseEjectionTime   time.Duration
+	MaxEjectionPercent int32
+}
+
+// faults injected by the fault-injection command into the requests from source to destination
+type FaultInjection struct {
+	Source      core.ResourceRef
+	Destination core.ResourceRef
+
+	// percentage of requests to delay, by FixedDelay
+	DelayPercent int32
+	FixedDelay   time.Duration
+
+	// percentage of requests to abort, with HttpStatus
+	AbortPercent int32
+	HttpStatus   int32
+}
+
+// retry and timeout settings applied by the retries command, unset (zero) values are left unchanged
+type Retries struct {
+	Attempts      int32
+	PerTryTimeout time.Duration
+	Timeout       time.Duration
 }
 
 type IngressTool struct {
@@ -63,6 +123,24 @@ type IngressTool struct {
 
 type Get struct {
 	Output string
+	// namespace of the resources, the supergloo namespace if empty
+	Namespace string
+	// list the resources of all namespaces rather than those of Namespace
+	AllNamespaces bool
+	// after listing the resources, print them again each time they change
+	Watch bool
+}
+
+type Preview struct {
+	// yaml file with meshes and routing rules to preview before applying them
+	Filename string
+	// show a diff against the resources in the cluster instead of the desired resources
+	Diff bool
+}
+
+type Describe struct {
+	// namespace of the described resource, the supergloo namespace if empty
+	Namespace string
 }
 
 type RoutingRule struct {
@@ -72,6 +150,38 @@ type RoutingRule struct {
 	Destinations     string
 	Matchers         []string
 	OverrideExisting bool
+
+	// given with the deprecated --matchers flag, appended to Matchers
+	DeprecatedMatchers []string
+
+	// upstreams to shift the traffic to, in the form <namespace>:<name>=<weight>, separated by commas
+	TrafficShifting string
+
+	Timeout            time.Duration
+	RetryAttempts      int32
+	RetryPerTryTimeout time.Duration
+
+	Cors               Cors
+	HeaderManipulation HeaderManipulation
+}
+
+// the cors policy of a routing rule, only set if one of the origins is given
+type Cors struct {
+	AllowOrigin      []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	MaxAge           time.Duration
+	AllowCredentials bool
+}
+
+// the headers added to and removed from requests and responses by a routing rule.
+// added headers are in the form <name>=<value>
+type HeaderManipulation struct {
+	AppendRequestHeaders  []string
+	RemoveRequestHeaders  []string
+	AppendResponseHeaders []string
+	RemoveResponseHeaders []string
 }
 
 // // Route Rule fields
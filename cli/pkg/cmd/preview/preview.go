@@ -0,0 +1,230 @@
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	crdv1 "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd/solo.io/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/rbac/v1alpha1"
+	superglooV1 "github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/constants"
+	"github.com/solo-io/supergloo/pkg/translator/istio"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+)
+
+func Cmd(opts *options.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: `Preview the istio resources written by supergloo`,
+		Long: `Render the DestinationRules, VirtualServices, ServiceRoles, ServiceRoleBindings and RbacConfigs
+supergloo writes for the meshes and routing rules in the cluster, as a diff against the resources currently in the cluster.
+Meshes, mesh policies and routing rules which have not been applied yet can be previewed with --filename.
+Nothing is written to the cluster.`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			return preview(opts)
+		},
+	}
+	pOp := &opts.Preview
+	flags := cmd.Flags()
+	flags.StringVarP(&pOp.Filename, "filename", "f", "", "yaml file with the meshes, mesh policies and routing rules to preview before applying them")
+	flags.BoolVar(&pOp.Diff, "diff", true, "show a diff against the resources in the cluster, or only the desired resources if false")
+	return cmd
+}
+
+func preview(opts *options.Options) error {
+	snap, err := clusterSnapshot()
+	if err != nil {
+		return err
+	}
+	if filename := opts.Preview.Filename; filename != "" {
+		if err := addResourcesFromFile(snap, filename); err != nil {
+			return err
+		}
+	}
+	desired := translate(snap, opts.Cache.KubeClient)
+	if !opts.Preview.Diff {
+		return printObjects(os.Stdout, desired)
+	}
+	current, err := currentObjects()
+	if err != nil {
+		return err
+	}
+	return printDiff(os.Stdout, current, desired)
+}
+
+// the resources read by the translator syncers, from all namespaces
+func clusterSnapshot() (*superglooV1.TranslatorSnapshot, error) {
+	meshClient, err := common.GetMeshClient()
+	if err != nil {
+		return nil, err
+	}
+	meshes, err := (*meshClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	mpClient, err := common.GetMeshPolicyClient()
+	if err != nil {
+		return nil, err
+	}
+	meshPolicies, err := (*mpClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	rrClient, err := common.GetRoutingRuleClient()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := (*rrClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	usClient, err := common.GetUpstreamClient()
+	if err != nil {
+		return nil, err
+	}
+	upstreams, err := (*usClient).List("", clients.ListOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return &superglooV1.TranslatorSnapshot{
+		Meshes:       meshes.ByNamespace(),
+		Meshpolicies: meshPolicies.ByNamespace(),
+		Routingrules: rules.ByNamespace(),
+		Upstreams:    upstreams.ByNamespace(),
+	}, nil
+}
+
+var yamlSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// adds the meshes, mesh policies and routing rules of the file to the snapshot, replacing the ones with the same name
+func addResourcesFromFile(snap *superglooV1.TranslatorSnapshot, filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	for _, doc := range yamlSeparator.Split(string(data), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		jsn, err := yaml.YAMLToJSON([]byte(doc))
+		if err != nil {
+			return errors.Wrapf(err, "parsing %v", filename)
+		}
+		var kubeRes crdv1.Resource
+		if err := json.Unmarshal(jsn, &kubeRes); err != nil {
+			return errors.Wrapf(err, "parsing %v", filename)
+		}
+		if kubeRes.Namespace == "" {
+			kubeRes.Namespace = "default"
+		}
+		switch kubeRes.Kind {
+		case superglooV1.MeshCrd.KindName:
+			mesh := &superglooV1.Mesh{}
+			if err := fromKubeResource(kubeRes, mesh); err != nil {
+				return err
+			}
+			meshes := snap.Meshes[mesh.Metadata.Namespace]
+			if existing, err := meshes.Find(mesh.Metadata.Namespace, mesh.Metadata.Name); err == nil {
+				*existing = *mesh
+				continue
+			}
+			snap.Meshes.Add(mesh)
+		case superglooV1.MeshPolicyCrd.KindName:
+			meshPolicy := &superglooV1.MeshPolicy{}
+			if err := fromKubeResource(kubeRes, meshPolicy); err != nil {
+				return err
+			}
+			meshPolicies := snap.Meshpolicies[meshPolicy.Metadata.Namespace]
+			if existing, err := meshPolicies.Find(meshPolicy.Metadata.Namespace, meshPolicy.Metadata.Name); err == nil {
+				*existing = *meshPolicy
+				continue
+			}
+			snap.Meshpolicies.Add(meshPolicy)
+		case superglooV1.RoutingRuleCrd.KindName:
+			rule := &superglooV1.RoutingRule{}
+			if err := fromKubeResource(kubeRes, rule); err != nil {
+				return err
+			}
+			rules := snap.Routingrules[rule.Metadata.Namespace]
+			if existing, err := rules.Find(rule.Metadata.Namespace, rule.Metadata.Name); err == nil {
+				*existing = *rule
+				continue
+			}
+			snap.Routingrules.Add(rule)
+		default:
+			return errors.Errorf("cannot preview %v %v, only meshes, mesh policies and routing rules are supported", kubeRes.Kind, kubeRes.Name)
+		}
+	}
+	return nil
+}
+
+func fromKubeResource(kubeRes crdv1.Resource, resource resources.InputResource) error {
+	if kubeRes.Spec != nil {
+		if err := protoutils.UnmarshalMap(*kubeRes.Spec, resource); err != nil {
+			return errors.Wrapf(err, "reading the spec of %v %v", kubeRes.Kind, kubeRes.Name)
+		}
+	}
+	resource.SetMetadata(kubeutils.FromKubeMeta(kubeRes.ObjectMeta))
+	return nil
+}
+
+// the istio resources the translator syncers write for the snapshot.
+// invalid routing rules and meshes which fail to translate are printed as warnings and left out
+func translate(snap *superglooV1.TranslatorSnapshot, kubeClient kubernetes.Interface) []object {
+	var desired []object
+	resourceErrs := make(reporter.ResourceErrors)
+	destinationRules, virtualServices, err := istio.TranslateRoutingRules(snap, nil, resourceErrs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	for res, err := range resourceErrs {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: routing rule %v is invalid and is ignored: %v\n", res.GetMetadata().Ref(), err)
+		}
+	}
+	for _, res := range destinationRules {
+		desired = append(desired, object{crd: v1alpha3.DestinationRuleCrd, resource: res})
+	}
+	for _, res := range virtualServices {
+		desired = append(desired, object{crd: v1alpha3.VirtualServiceCrd, resource: res})
+	}
+
+	for _, mesh := range snap.Meshes.List() {
+		if mesh.GetIstio() == nil {
+			continue
+		}
+		policy := shared.MergedPolicy(mesh, snap.Meshpolicies.List(), snap.Upstreams.List())
+		if policy == nil {
+			continue
+		}
+		rbacConfig, serviceRoles, serviceRoleBindings, err := istio.TranslatePolicy(constants.SuperglooNamespace, nil, kubeClient, snap.Upstreams, policy)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: the policy of mesh %v is not fully applied: %v\n", mesh.Metadata.Ref(), err)
+		}
+		desired = append(desired, object{crd: v1alpha1.RbacConfigCrd, resource: rbacConfig})
+		for _, res := range serviceRoles {
+			desired = append(desired, object{crd: v1alpha1.ServiceRoleCrd, resource: res})
+		}
+		for _, res := range serviceRoleBindings {
+			desired = append(desired, object{crd: v1alpha1.ServiceRoleBindingCrd, resource: res})
+		}
+	}
+	return desired
+}
@@ -0,0 +1,218 @@
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/crd"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/supergloo/cli/pkg/common"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/rbac/v1alpha1"
+)
+
+// a resource along with the crd it is written as
+type object struct {
+	crd      crd.Crd
+	resource resources.InputResource
+}
+
+func (o object) key() string {
+	return fmt.Sprintf("%v %v", o.crd.KindName, o.resource.GetMetadata().Ref().Key())
+}
+
+// renders the resource as it is written to kubernetes, without the fields set by the cluster
+func (o object) yaml() (string, error) {
+	jsn, err := json.Marshal(o.crd.KubeResource(o.resource))
+	if err != nil {
+		return "", err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(jsn, &obj); err != nil {
+		return "", err
+	}
+	delete(obj, "status")
+	if meta, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"resourceVersion", "creationTimestamp", "generation", "selfLink", "uid"} {
+			delete(meta, field)
+		}
+	}
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+var istioKinds = []object{
+	{crd: v1alpha3.DestinationRuleCrd, resource: &v1alpha3.DestinationRule{}},
+	{crd: v1alpha3.VirtualServiceCrd, resource: &v1alpha3.VirtualService{}},
+	{crd: v1alpha1.RbacConfigCrd, resource: &v1alpha1.RbacConfig{}},
+	{crd: v1alpha1.ServiceRoleCrd, resource: &v1alpha1.ServiceRole{}},
+	{crd: v1alpha1.ServiceRoleBindingCrd, resource: &v1alpha1.ServiceRoleBinding{}},
+}
+
+// the istio resources in the cluster which have been written by supergloo
+func currentObjects() ([]object, error) {
+	cfg, err := common.GetKubernetesConfig()
+	if err != nil {
+		return nil, err
+	}
+	cache := kube.NewKubeCache()
+	var current []object
+	for _, kind := range istioKinds {
+		rcFactory := &factory.KubeResourceClientFactory{
+			Crd:         kind.crd,
+			Cfg:         cfg,
+			SharedCache: cache,
+		}
+		rc, err := rcFactory.NewResourceClient(factory.NewResourceClientParams{ResourceType: kind.resource})
+		if err != nil {
+			return nil, err
+		}
+		if err := rc.Register(); err != nil {
+			return nil, err
+		}
+		list, err := rc.List("", clients.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range list {
+			if res.GetMetadata().Annotations["created_by"] != "supergloo" {
+				continue
+			}
+			if inputRes, ok := res.(resources.InputResource); ok {
+				current = append(current, object{crd: kind.crd, resource: inputRes})
+			}
+		}
+	}
+	return current, nil
+}
+
+func renderObjects(objects []object) (map[string]string, error) {
+	rendered := make(map[string]string)
+	for _, obj := range objects {
+		data, err := obj.yaml()
+		if err != nil {
+			return nil, err
+		}
+		rendered[obj.key()] = data
+	}
+	return rendered, nil
+}
+
+func sortedKeys(rendered ...map[string]string) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, objs := range rendered {
+		for key := range objs {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// prints the objects as a multi-document yaml
+func printObjects(w io.Writer, objects []object) error {
+	rendered, err := renderObjects(objects)
+	if err != nil {
+		return err
+	}
+	for _, key := range sortedKeys(rendered) {
+		if _, err := fmt.Fprintf(w, "---\n%v", rendered[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prints the changes supergloo would make to the current objects to get the desired ones
+func printDiff(w io.Writer, current, desired []object) error {
+	currentYaml, err := renderObjects(current)
+	if err != nil {
+		return err
+	}
+	desiredYaml, err := renderObjects(desired)
+	if err != nil {
+		return err
+	}
+	for _, key := range sortedKeys(currentYaml, desiredYaml) {
+		before, exists := currentYaml[key]
+		after, wanted := desiredYaml[key]
+		var header string
+		switch {
+		case !exists:
+			header = "created"
+		case !wanted:
+			header = "deleted"
+		case before == after:
+			fmt.Fprintf(w, "%v: unchanged\n", key)
+			continue
+		default:
+			header = "updated"
+		}
+		fmt.Fprintf(w, "%v: %v\n", key, header)
+		for _, line := range diffLines(splitLines(before), splitLines(after)) {
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// returns the lines of before and after, prefixed by "-" if they were removed, "+" if they were added
+// or " " if they are in both, based on the longest common subsequence of the two
+func diffLines(before, after []string) []string {
+	// common[i][j] is the length of the longest common subsequence of before[i:] and after[j:]
+	common := make([][]int, len(before)+1)
+	for i := range common {
+		common[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+	var lines []string
+	i, j := 0, 0
+	for i < len(before) || j < len(after) {
+		switch {
+		case i < len(before) && j < len(after) && before[i] == after[j]:
+			lines = append(lines, " "+before[i])
+			i++
+			j++
+		case j == len(after) || (i < len(before) && common[i+1][j] >= common[i][j+1]):
+			lines = append(lines, "-"+before[i])
+			i++
+		default:
+			lines = append(lines, "+"+after[j])
+			j++
+		}
+	}
+	return lines
+}
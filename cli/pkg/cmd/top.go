@@ -4,12 +4,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/config"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/create"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/describe"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/get"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/ingresstoolbox"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/initsupergloo"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/install"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/meshtoolbox"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
+	"github.com/solo-io/supergloo/cli/pkg/cmd/preview"
 	"github.com/solo-io/supergloo/cli/pkg/cmd/uninstall"
 	"github.com/solo-io/supergloo/cli/pkg/setup"
 	"github.com/spf13/cobra"
@@ -36,8 +38,10 @@ func App(version string) *cobra.Command {
 		uninstall.Cmd(&opts),
 
 		get.Cmd(&opts),
+		describe.Cmd(&opts),
 		create.Cmd(&opts),
 		config.Cmd(&opts),
+		preview.Cmd(&opts),
 		meshtoolbox.FaultInjection(&opts),
 		meshtoolbox.LoadBalancing(&opts),
 		meshtoolbox.Retries(&opts),
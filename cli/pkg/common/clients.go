@@ -66,6 +66,25 @@ func GetMeshClient() (*superglooV1.MeshClient, error) {
 	return &meshClient, nil
 }
 
+func GetMeshPolicyClient() (*superglooV1.MeshPolicyClient, error) {
+	config, err := GetKubernetesConfig()
+	if err != nil {
+		return nil, err
+	}
+	meshPolicyClient, err := superglooV1.NewMeshPolicyClient(&factory.KubeResourceClientFactory{
+		Crd:         superglooV1.MeshPolicyCrd,
+		Cfg:         config,
+		SharedCache: kube.NewKubeCache(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = meshPolicyClient.Register(); err != nil {
+		return nil, err
+	}
+	return &meshPolicyClient, nil
+}
+
 func GetRoutingRuleClient() (*superglooV1.RoutingRuleClient, error) {
 	config, err := GetKubernetesConfig()
 	if err != nil {
@@ -2,6 +2,8 @@ package common
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/solo-io/supergloo/cli/pkg/cmd/options"
 	"gopkg.in/AlecAivazis/survey.v1"
@@ -42,3 +44,66 @@ func ChooseBool(message string) (bool, error) {
 
 	return choice == yes, nil
 }
+
+func ChooseInt32(message string) (int32, error) {
+
+	question := &survey.Input{
+		Message: message,
+	}
+
+	var choice string
+	validate := func(ans interface{}) error {
+		_, err := strconv.ParseInt(ans.(string), 10, 32)
+		return err
+	}
+	if err := survey.AskOne(question, &choice, survey.ComposeValidators(survey.Required, validate)); err != nil {
+		// this should not error
+		fmt.Println("error with input")
+		return 0, err
+	}
+
+	value, err := strconv.ParseInt(choice, 10, 32)
+	return int32(value), err
+}
+
+// durations are entered in the format of time.ParseDuration, e.g. "1.5s" or "300ms"
+func ChooseDuration(message string) (time.Duration, error) {
+
+	question := &survey.Input{
+		Message: message,
+	}
+
+	var choice string
+	validate := func(ans interface{}) error {
+		_, err := time.ParseDuration(ans.(string))
+		return err
+	}
+	if err := survey.AskOne(question, &choice, survey.ComposeValidators(survey.Required, validate)); err != nil {
+		// this should not error
+		fmt.Println("error with input")
+		return 0, err
+	}
+
+	return time.ParseDuration(choice)
+}
+
+// the answer is rejected until it passes validate
+func ChooseString(message, help string, validate func(string) error) (string, error) {
+
+	question := &survey.Input{
+		Message: message,
+		Help:    help,
+	}
+
+	var choice string
+	validator := func(ans interface{}) error {
+		return validate(ans.(string))
+	}
+	if err := survey.AskOne(question, &choice, survey.ComposeValidators(survey.Required, validator)); err != nil {
+		// this should not error
+		fmt.Println("error with input")
+		return "", err
+	}
+
+	return choice, nil
+}
@@ -218,18 +218,26 @@ func AllPodsReadyOrSucceeded(namespace string, client *kubernetes.Clientset, pod
 		if len(podNames) > 0 && !common.ContainsSubstring(podNames, pod.Name) {
 			continue
 		}
-		for _, condition := range pod.Status.Conditions {
-			if pod.Status.Phase == kubecore.PodSucceeded {
-				continue
-			}
-			if condition.Type == kubecore.PodReady && condition.Status != kubecore.ConditionTrue {
-				done = false
-			}
+		if !PodReadyOrSucceeded(pod) {
+			done = false
 		}
 	}
 	return done
 }
 
+// a pod is done if it has completed successfully, or if none of its conditions says it is not ready
+func PodReadyOrSucceeded(pod kubecore.Pod) bool {
+	if pod.Status.Phase == kubecore.PodSucceeded {
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == kubecore.PodReady && condition.Status != kubecore.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
 func LoopUntilAllPodsReadyOrTimeout(namespace string, client *kubernetes.Clientset, podNames ...string) bool {
 	for i := 0; i < 30; i++ {
 		if AllPodsReadyOrSucceeded(namespace, client, podNames...) {
@@ -191,7 +191,7 @@ func (m *VirtualService) Reset()         { *m = VirtualService{} }
 func (m *VirtualService) String() string { return proto.CompactTextString(m) }
 func (*VirtualService) ProtoMessage()    {}
 func (*VirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{0}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{0}
 }
 func (m *VirtualService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualService.Unmarshal(m, b)
@@ -420,7 +420,7 @@ func (m *Destination) Reset()         { *m = Destination{} }
 func (m *Destination) String() string { return proto.CompactTextString(m) }
 func (*Destination) ProtoMessage()    {}
 func (*Destination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{1}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{1}
 }
 func (m *Destination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Destination.Unmarshal(m, b)
@@ -510,16 +510,20 @@ type HTTPRoute struct {
 	// Http headers to remove before returning the response to the caller
 	// $hide_from_docs
 	RemoveResponseHeaders []string `protobuf:"bytes,12,rep,name=remove_response_headers,json=removeResponseHeaders" json:"remove_response_headers,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	// Percentage of the traffic to be mirrored by the `mirror` field.
+	// If this field is absent, all the traffic (100%) will be mirrored.
+	// Max value is 100.
+	MirrorPercent        *types.UInt32Value `protobuf:"bytes,18,opt,name=mirror_percent,json=mirrorPercent" json:"mirror_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *HTTPRoute) Reset()         { *m = HTTPRoute{} }
 func (m *HTTPRoute) String() string { return proto.CompactTextString(m) }
 func (*HTTPRoute) ProtoMessage()    {}
 func (*HTTPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{2}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{2}
 }
 func (m *HTTPRoute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPRoute.Unmarshal(m, b)
@@ -623,6 +627,13 @@ func (m *HTTPRoute) GetRemoveResponseHeaders() []string {
 	return nil
 }
 
+func (m *HTTPRoute) GetMirrorPercent() *types.UInt32Value {
+	if m != nil {
+		return m.MirrorPercent
+	}
+	return nil
+}
+
 // Describes match conditions and actions for routing unterminated TLS
 // traffic (TLS/HTTPS) The following routing rule forwards unterminated TLS
 // traffic arriving at port 443 of gateway called "mygateway" to internal
@@ -674,7 +685,7 @@ func (m *TLSRoute) Reset()         { *m = TLSRoute{} }
 func (m *TLSRoute) String() string { return proto.CompactTextString(m) }
 func (*TLSRoute) ProtoMessage()    {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{3}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{3}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TLSRoute.Unmarshal(m, b)
@@ -749,7 +760,7 @@ func (m *TCPRoute) Reset()         { *m = TCPRoute{} }
 func (m *TCPRoute) String() string { return proto.CompactTextString(m) }
 func (*TCPRoute) ProtoMessage()    {}
 func (*TCPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{4}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{4}
 }
 func (m *TCPRoute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TCPRoute.Unmarshal(m, b)
@@ -886,7 +897,7 @@ func (m *HTTPMatchRequest) Reset()         { *m = HTTPMatchRequest{} }
 func (m *HTTPMatchRequest) String() string { return proto.CompactTextString(m) }
 func (*HTTPMatchRequest) ProtoMessage()    {}
 func (*HTTPMatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{5}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{5}
 }
 func (m *HTTPMatchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPMatchRequest.Unmarshal(m, b)
@@ -1046,7 +1057,7 @@ func (m *DestinationWeight) Reset()         { *m = DestinationWeight{} }
 func (m *DestinationWeight) String() string { return proto.CompactTextString(m) }
 func (*DestinationWeight) ProtoMessage()    {}
 func (*DestinationWeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{6}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{6}
 }
 func (m *DestinationWeight) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DestinationWeight.Unmarshal(m, b)
@@ -1112,7 +1123,7 @@ func (m *L4MatchAttributes) Reset()         { *m = L4MatchAttributes{} }
 func (m *L4MatchAttributes) String() string { return proto.CompactTextString(m) }
 func (*L4MatchAttributes) ProtoMessage()    {}
 func (*L4MatchAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{7}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{7}
 }
 func (m *L4MatchAttributes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_L4MatchAttributes.Unmarshal(m, b)
@@ -1203,7 +1214,7 @@ func (m *TLSMatchAttributes) Reset()         { *m = TLSMatchAttributes{} }
 func (m *TLSMatchAttributes) String() string { return proto.CompactTextString(m) }
 func (*TLSMatchAttributes) ProtoMessage()    {}
 func (*TLSMatchAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{8}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{8}
 }
 func (m *TLSMatchAttributes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TLSMatchAttributes.Unmarshal(m, b)
@@ -1305,7 +1316,7 @@ func (m *HTTPRedirect) Reset()         { *m = HTTPRedirect{} }
 func (m *HTTPRedirect) String() string { return proto.CompactTextString(m) }
 func (*HTTPRedirect) ProtoMessage()    {}
 func (*HTTPRedirect) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{9}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{9}
 }
 func (m *HTTPRedirect) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPRedirect.Unmarshal(m, b)
@@ -1381,7 +1392,7 @@ func (m *HTTPRewrite) Reset()         { *m = HTTPRewrite{} }
 func (m *HTTPRewrite) String() string { return proto.CompactTextString(m) }
 func (*HTTPRewrite) ProtoMessage()    {}
 func (*HTTPRewrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{10}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{10}
 }
 func (m *HTTPRewrite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPRewrite.Unmarshal(m, b)
@@ -1432,7 +1443,7 @@ func (m *StringMatch) Reset()         { *m = StringMatch{} }
 func (m *StringMatch) String() string { return proto.CompactTextString(m) }
 func (*StringMatch) ProtoMessage()    {}
 func (*StringMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{11}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{11}
 }
 func (m *StringMatch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StringMatch.Unmarshal(m, b)
@@ -1618,7 +1629,7 @@ func (m *HTTPRetry) Reset()         { *m = HTTPRetry{} }
 func (m *HTTPRetry) String() string { return proto.CompactTextString(m) }
 func (*HTTPRetry) ProtoMessage()    {}
 func (*HTTPRetry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{12}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{12}
 }
 func (m *HTTPRetry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPRetry.Unmarshal(m, b)
@@ -1716,7 +1727,7 @@ func (m *CorsPolicy) Reset()         { *m = CorsPolicy{} }
 func (m *CorsPolicy) String() string { return proto.CompactTextString(m) }
 func (*CorsPolicy) ProtoMessage()    {}
 func (*CorsPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{13}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{13}
 }
 func (m *CorsPolicy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CorsPolicy.Unmarshal(m, b)
@@ -1802,7 +1813,7 @@ func (m *HTTPFaultInjection) Reset()         { *m = HTTPFaultInjection{} }
 func (m *HTTPFaultInjection) String() string { return proto.CompactTextString(m) }
 func (*HTTPFaultInjection) ProtoMessage()    {}
 func (*HTTPFaultInjection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{14}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{14}
 }
 func (m *HTTPFaultInjection) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPFaultInjection.Unmarshal(m, b)
@@ -1883,7 +1894,7 @@ func (m *HTTPFaultInjection_Delay) Reset()         { *m = HTTPFaultInjection_Del
 func (m *HTTPFaultInjection_Delay) String() string { return proto.CompactTextString(m) }
 func (*HTTPFaultInjection_Delay) ProtoMessage()    {}
 func (*HTTPFaultInjection_Delay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{14, 0}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{14, 0}
 }
 func (m *HTTPFaultInjection_Delay) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPFaultInjection_Delay.Unmarshal(m, b)
@@ -2064,7 +2075,7 @@ func (m *HTTPFaultInjection_Abort) Reset()         { *m = HTTPFaultInjection_Abo
 func (m *HTTPFaultInjection_Abort) String() string { return proto.CompactTextString(m) }
 func (*HTTPFaultInjection_Abort) ProtoMessage()    {}
 func (*HTTPFaultInjection_Abort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{14, 1}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{14, 1}
 }
 func (m *HTTPFaultInjection_Abort) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPFaultInjection_Abort.Unmarshal(m, b)
@@ -2234,7 +2245,7 @@ func (m *PortSelector) Reset()         { *m = PortSelector{} }
 func (m *PortSelector) String() string { return proto.CompactTextString(m) }
 func (*PortSelector) ProtoMessage()    {}
 func (*PortSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_virtual_service_b35ddecf6ce6fb9e, []int{15}
+	return fileDescriptor_virtual_service_bde8e79b7d8bbd4a, []int{15}
 }
 func (m *PortSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PortSelector.Unmarshal(m, b)
@@ -2497,6 +2508,9 @@ func (this *HTTPRoute) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.MirrorPercent.Equal(that1.MirrorPercent) {
+		return false
+	}
 	if that1 == nil {
 		return this == nil
 	} else if this == nil {
@@ -3414,107 +3428,108 @@ func (this *PortSelector_Name) Equal(that interface{}) bool {
 }
 
 func init() {
-	proto.RegisterFile("virtual_service.proto", fileDescriptor_virtual_service_b35ddecf6ce6fb9e)
-}
-
-var fileDescriptor_virtual_service_b35ddecf6ce6fb9e = []byte{
-	// 1565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xef, 0x6e, 0x1b, 0xc7,
-	0x11, 0x17, 0xff, 0x8a, 0x1c, 0x4a, 0xb6, 0xb8, 0x96, 0xdd, 0x33, 0xdb, 0xda, 0x32, 0x0b, 0xd7,
-	0x02, 0x0a, 0x1f, 0x21, 0xba, 0x75, 0x55, 0xd7, 0x76, 0x2d, 0xca, 0x7f, 0x54, 0x40, 0x42, 0x85,
-	0x93, 0xea, 0x16, 0x85, 0x5b, 0xe2, 0x78, 0x1c, 0x1d, 0xd7, 0x3a, 0xde, 0x5e, 0xf7, 0xf6, 0x24,
-	0xf2, 0x05, 0xda, 0x57, 0xc8, 0x97, 0x7c, 0xcf, 0x87, 0xbc, 0x40, 0x80, 0x3c, 0x40, 0x1e, 0x21,
-	0x40, 0x00, 0x7f, 0xc8, 0x23, 0x04, 0x08, 0x90, 0x8f, 0xc1, 0xee, 0xde, 0x91, 0x27, 0x91, 0xa2,
-	0x28, 0xc4, 0xf9, 0xc4, 0x9b, 0xd9, 0xdf, 0x6f, 0x76, 0x77, 0x76, 0x66, 0x76, 0x96, 0x70, 0xf3,
-	0x84, 0x72, 0x11, 0xd9, 0x5e, 0x3b, 0x44, 0x7e, 0x42, 0x1d, 0x34, 0x03, 0xce, 0x04, 0x23, 0x37,
-	0x7c, 0x14, 0xa7, 0x8c, 0x1f, 0x53, 0xdf, 0x35, 0x69, 0x28, 0x28, 0x33, 0x29, 0xab, 0xdd, 0x71,
-	0x19, 0x73, 0x3d, 0x6c, 0x28, 0x48, 0x27, 0x3a, 0x6a, 0x74, 0x23, 0x6e, 0x0b, 0xca, 0x7c, 0x4d,
-	0x9a, 0x1c, 0x3f, 0xe5, 0x76, 0x10, 0x20, 0x0f, 0xe3, 0xf1, 0x55, 0x97, 0xb9, 0x4c, 0x7d, 0x36,
-	0xe4, 0x57, 0xac, 0xdd, 0x70, 0xa9, 0xe8, 0x45, 0x1d, 0xd3, 0x61, 0xfd, 0x46, 0xc8, 0x3c, 0xf6,
-	0x90, 0x32, 0xfd, 0x7b, 0x4c, 0x45, 0xc3, 0x0e, 0x68, 0xe3, 0x64, 0xa3, 0xd1, 0x47, 0x61, 0x77,
-	0x6d, 0x61, 0xc7, 0x94, 0xc6, 0x1c, 0x94, 0x50, 0xd8, 0x22, 0x8a, 0x67, 0xae, 0x7f, 0x93, 0x85,
-	0x6b, 0x6f, 0xf5, 0x46, 0x0f, 0xf4, 0x3e, 0xc9, 0x1b, 0x28, 0x6a, 0x88, 0x51, 0x5c, 0xcb, 0xac,
-	0x57, 0x9a, 0xab, 0xa6, 0xc3, 0x38, 0x9a, 0xd2, 0x8c, 0x49, 0x99, 0x79, 0xa0, 0xc6, 0x5a, 0xb7,
-	0xbf, 0xfa, 0x70, 0x77, 0xe1, 0xbb, 0x0f, 0x77, 0xab, 0x02, 0x43, 0xd1, 0xa5, 0x47, 0x47, 0x4f,
-	0xea, 0xd4, 0xf5, 0x19, 0xc7, 0xba, 0x15, 0xd3, 0xc9, 0x26, 0x94, 0x92, 0xe5, 0x19, 0x8b, 0xca,
-	0xd4, 0xad, 0xb3, 0xa6, 0xf6, 0xe2, 0xd1, 0x56, 0x5e, 0x1a, 0xb3, 0x46, 0x68, 0xb2, 0x0a, 0x85,
-	0x1e, 0x0b, 0x45, 0x68, 0x64, 0xd6, 0x72, 0xeb, 0x65, 0x4b, 0x0b, 0xa4, 0x06, 0x25, 0xd7, 0x16,
-	0x78, 0x6a, 0x0f, 0x43, 0x23, 0xab, 0x06, 0x46, 0x32, 0x69, 0x42, 0xbe, 0x27, 0x44, 0x60, 0xe4,
-	0xd6, 0x72, 0xeb, 0x95, 0xe6, 0x1d, 0x73, 0xca, 0x29, 0x99, 0x3b, 0x87, 0x87, 0xfb, 0x16, 0x8b,
-	0x04, 0x5a, 0x0a, 0x4b, 0x1a, 0x90, 0x13, 0x5e, 0x68, 0x14, 0x14, 0xe5, 0xd7, 0x53, 0x29, 0x87,
-	0xbb, 0x07, 0x9a, 0x21, 0x91, 0x8a, 0xe0, 0x04, 0x46, 0x7e, 0x16, 0x61, 0x7b, 0x3f, 0x21, 0x38,
-	0x41, 0x3d, 0x80, 0xca, 0x4b, 0x0c, 0x05, 0xf5, 0x55, 0x30, 0x10, 0x02, 0x79, 0xb9, 0x13, 0x23,
-	0xb3, 0x96, 0x59, 0x2f, 0x5b, 0xea, 0x9b, 0xdc, 0x82, 0x62, 0x18, 0x75, 0x42, 0x14, 0x46, 0x56,
-	0x69, 0x63, 0x89, 0xfc, 0x01, 0xf2, 0x01, 0xe3, 0xc2, 0xc8, 0x29, 0xc7, 0xdd, 0x9b, 0x3a, 0xd9,
-	0x3e, 0xe3, 0xe2, 0x00, 0x3d, 0x74, 0x04, 0xe3, 0x96, 0x82, 0xd7, 0x3f, 0x2f, 0x42, 0x79, 0xb4,
-	0x4f, 0xf2, 0x67, 0x28, 0xf4, 0x6d, 0xe1, 0xf4, 0x94, 0x1f, 0x2b, 0xcd, 0xfb, 0x17, 0xba, 0x65,
-	0x4f, 0xa2, 0x2c, 0xfc, 0x6f, 0x84, 0xa1, 0xb0, 0x34, 0x87, 0x3c, 0x85, 0x02, 0x97, 0x56, 0x94,
-	0xaf, 0x2b, 0xcd, 0xdf, 0x4e, 0x25, 0xa7, 0xb6, 0xf7, 0x0f, 0xa4, 0x6e, 0x4f, 0x58, 0x9a, 0x44,
-	0x9e, 0x41, 0x89, 0x63, 0x97, 0x72, 0x74, 0x66, 0xef, 0x41, 0x2d, 0x36, 0x06, 0x5a, 0x23, 0x0a,
-	0x79, 0x02, 0x8b, 0x1c, 0x4f, 0x39, 0x15, 0x68, 0xe4, 0x15, 0x7b, 0x6d, 0x06, 0x5b, 0xe1, 0xac,
-	0x84, 0x40, 0x7e, 0x07, 0xd5, 0x53, 0xec, 0x84, 0xcc, 0x39, 0x46, 0xd1, 0x8e, 0x02, 0x97, 0xdb,
-	0x5d, 0x34, 0x0a, 0x6b, 0x99, 0xf5, 0x92, 0xb5, 0x32, 0x1a, 0xf8, 0xbb, 0xd6, 0x93, 0x47, 0xb0,
-	0x28, 0x68, 0x1f, 0x59, 0x24, 0xe2, 0x70, 0xbf, 0x6d, 0xea, 0x64, 0x35, 0x93, 0x64, 0x35, 0x5f,
-	0xc6, 0xc9, 0x6c, 0x25, 0x48, 0xb2, 0x29, 0x57, 0x27, 0x38, 0xc5, 0x30, 0x0e, 0xec, 0x19, 0x01,
-	0x87, 0x82, 0x0f, 0xad, 0x04, 0x4e, 0x9e, 0x41, 0xe1, 0xc8, 0x8e, 0x3c, 0x61, 0x94, 0x14, 0xef,
-	0xc1, 0x85, 0xbc, 0xd7, 0x12, 0xf5, 0x57, 0xff, 0x3d, 0x3a, 0x6a, 0x6a, 0xcd, 0x22, 0x9b, 0x50,
-	0xec, 0x53, 0xce, 0x19, 0x37, 0xca, 0x33, 0xbc, 0x92, 0x3a, 0x14, 0x2b, 0xc6, 0x93, 0x17, 0x50,
-	0x71, 0x18, 0x0f, 0xdb, 0x01, 0xf3, 0xa8, 0x33, 0x34, 0x40, 0xd1, 0xef, 0x4e, 0xa5, 0x6f, 0x33,
-	0x1e, 0xee, 0x2b, 0x98, 0x05, 0xce, 0xe8, 0x9b, 0xfc, 0x13, 0xae, 0xc9, 0xa2, 0xe5, 0x77, 0xdb,
-	0x3d, 0xb4, 0xbb, 0xc8, 0x43, 0xa3, 0xa2, 0x02, 0x63, 0x63, 0x76, 0xb2, 0x99, 0x5b, 0x8a, 0xb4,
-	0xa3, 0x39, 0xaf, 0x7c, 0xe9, 0x8e, 0x65, 0x3b, 0xad, 0x23, 0x8f, 0xe1, 0x17, 0x1c, 0xfb, 0xec,
-	0x04, 0xdb, 0x1c, 0xc3, 0x80, 0xf9, 0x21, 0x8e, 0xa6, 0x58, 0x52, 0x79, 0x7e, 0x53, 0x0f, 0x5b,
-	0xf1, 0x68, 0xcc, 0xab, 0xbd, 0x00, 0x32, 0x69, 0x9c, 0xac, 0x40, 0xee, 0x18, 0x87, 0x71, 0x92,
-	0xc9, 0x4f, 0x59, 0x4e, 0x4e, 0x6c, 0x2f, 0xc2, 0x38, 0xc5, 0xb4, 0xf0, 0x24, 0xbb, 0x99, 0xa9,
-	0xff, 0x3f, 0x03, 0xa5, 0x24, 0xc7, 0xe5, 0xd9, 0xa4, 0xb3, 0xe5, 0xc1, 0x45, 0x15, 0x41, 0x25,
-	0xcb, 0x96, 0x10, 0x9c, 0x76, 0x22, 0x81, 0xe1, 0x47, 0xc9, 0x97, 0xfa, 0xff, 0xe4, 0x4a, 0xe2,
-	0xe2, 0x21, 0x4d, 0xa5, 0x57, 0x32, 0xdd, 0xd4, 0xee, 0xef, 0x7f, 0x96, 0x85, 0x7c, 0x9f, 0x87,
-	0x95, 0xf3, 0x25, 0x81, 0x34, 0x21, 0x17, 0x71, 0xaa, 0x7c, 0x7a, 0x51, 0xd0, 0x1d, 0x08, 0x4e,
-	0x7d, 0x57, 0xb3, 0x24, 0x58, 0xc6, 0x6a, 0xe8, 0xf4, 0xb0, 0xaf, 0xdd, 0x3e, 0x0f, 0x2d, 0xc6,
-	0xab, 0x28, 0x47, 0xd1, 0x63, 0xdd, 0xb8, 0x72, 0xcc, 0xc1, 0xd4, 0x78, 0xf2, 0x1c, 0xca, 0x76,
-	0x24, 0x7a, 0x8c, 0x53, 0x31, 0x9c, 0x59, 0x38, 0xd2, 0xe4, 0x31, 0x85, 0xec, 0xc2, 0x62, 0x12,
-	0x79, 0xfa, 0x5a, 0x68, 0xce, 0x55, 0x32, 0xcd, 0x33, 0xd1, 0x9d, 0x98, 0x90, 0xf5, 0x5e, 0xd5,
-	0x70, 0x59, 0x58, 0x96, 0x75, 0x81, 0x26, 0xef, 0x60, 0x39, 0x64, 0x11, 0x77, 0xb0, 0xed, 0xd9,
-	0x1d, 0xf4, 0x64, 0x01, 0x91, 0xf3, 0xfc, 0x71, 0xbe, 0x79, 0x0e, 0x14, 0x75, 0x57, 0x31, 0xf5,
-	0x64, 0x4b, 0x61, 0x4a, 0x75, 0xe6, 0x8a, 0x2c, 0x9d, 0xbd, 0x22, 0x6b, 0xef, 0x60, 0xe9, 0x92,
-	0x3c, 0x79, 0x9c, 0xce, 0x93, 0x79, 0x3c, 0x37, 0xce, 0xa4, 0xda, 0x5f, 0xa0, 0x3a, 0xb1, 0xb8,
-	0x2b, 0xa5, 0x22, 0x83, 0xea, 0x44, 0x4c, 0x92, 0x16, 0x54, 0xba, 0x63, 0xe5, 0xcc, 0xf8, 0x4b,
-	0x17, 0xbd, 0x34, 0x49, 0xde, 0xb0, 0xa7, 0xca, 0x9a, 0x9a, 0xb3, 0x60, 0xc5, 0x52, 0xfd, 0x8b,
-	0x2c, 0x54, 0x27, 0x72, 0x88, 0x34, 0xe0, 0x46, 0x8a, 0xdc, 0x0e, 0xa3, 0x8e, 0x8f, 0xa3, 0x46,
-	0x84, 0xa4, 0x86, 0x0e, 0xf4, 0xc8, 0xe8, 0x90, 0xb3, 0xa9, 0x43, 0xfe, 0xcd, 0xe8, 0x90, 0x35,
-	0x5f, 0xc5, 0x71, 0x39, 0x39, 0x2b, 0xcd, 0x24, 0xff, 0x3e, 0x1f, 0x09, 0xba, 0xaf, 0xd8, 0x9c,
-	0x2f, 0xd9, 0xaf, 0x14, 0x0a, 0x85, 0x73, 0xa1, 0xf0, 0x93, 0x0f, 0xeb, 0xeb, 0x2c, 0x90, 0xc9,
-	0x4a, 0x48, 0x7e, 0x09, 0xe5, 0xd0, 0xa7, 0xed, 0x74, 0xef, 0x56, 0x0a, 0x7d, 0xba, 0xa3, 0xda,
-	0xb7, 0x0b, 0x3c, 0x9b, 0xbd, 0xd4, 0xb3, 0xb9, 0x59, 0x9e, 0xcd, 0x4f, 0xf1, 0xec, 0x7f, 0xce,
-	0x7b, 0x56, 0xe7, 0xf2, 0x9f, 0xe6, 0x2c, 0xe8, 0x57, 0x72, 0x6d, 0xf1, 0x63, 0xbb, 0xf6, 0x39,
-	0x2c, 0xa5, 0x7b, 0x22, 0xc9, 0x4d, 0x4a, 0x6f, 0x59, 0x17, 0xd6, 0x5f, 0xa5, 0x8b, 0x9c, 0xe6,
-	0x8f, 0x15, 0xf5, 0x67, 0x50, 0x49, 0x75, 0x45, 0x57, 0xa6, 0x23, 0x54, 0x52, 0x19, 0x4e, 0x6e,
-	0x41, 0x01, 0x07, 0xb6, 0x13, 0xf7, 0xac, 0x3b, 0x0b, 0x96, 0x16, 0x89, 0x01, 0xc5, 0x80, 0xe3,
-	0x11, 0x1d, 0x68, 0x0b, 0x3b, 0x0b, 0x56, 0x2c, 0x4b, 0x06, 0x47, 0x17, 0x07, 0x3a, 0xe6, 0x25,
-	0x43, 0x89, 0xad, 0x25, 0x00, 0x75, 0x3d, 0xb5, 0xc5, 0x30, 0xc0, 0xfa, 0xfb, 0xb8, 0x4d, 0x95,
-	0xdd, 0x91, 0xf4, 0xa7, 0x2d, 0x04, 0xf6, 0x03, 0x15, 0x35, 0x32, 0x47, 0x47, 0x32, 0xd9, 0x82,
-	0xeb, 0x01, 0xf2, 0xb6, 0xe0, 0xc3, 0x76, 0xd2, 0xa7, 0x65, 0x2f, 0xeb, 0xd3, 0x96, 0x03, 0xe4,
-	0x87, 0x7c, 0x78, 0xa8, 0xf1, 0xf5, 0x4f, 0xb3, 0x00, 0xe3, 0x9e, 0x86, 0xdc, 0x83, 0x25, 0xdb,
-	0xf3, 0xd8, 0x69, 0x9b, 0x71, 0xea, 0x52, 0x3f, 0x8e, 0xd3, 0x8a, 0xd2, 0xfd, 0x4d, 0xa9, 0x64,
-	0x94, 0x69, 0x88, 0xbe, 0x56, 0x92, 0x20, 0xd5, 0xbc, 0x3d, 0xad, 0x1b, 0x83, 0x92, 0x1b, 0x23,
-	0x97, 0x02, 0x25, 0xad, 0xcd, 0x7d, 0xb8, 0x86, 0x83, 0x80, 0xa5, 0x3a, 0x9a, 0xbc, 0x42, 0x2d,
-	0x6b, 0x6d, 0x02, 0x6b, 0xc2, 0x62, 0xdf, 0x1e, 0xb4, 0x6d, 0x57, 0x37, 0xaa, 0x33, 0x77, 0x57,
-	0xec, 0xdb, 0x83, 0x2d, 0x57, 0xbe, 0xd3, 0xaa, 0x7a, 0x7e, 0x87, 0x63, 0x17, 0x7d, 0x41, 0x6d,
-	0x2f, 0x79, 0xb2, 0xd5, 0x26, 0xd8, 0x2d, 0xc6, 0xbc, 0xb7, 0x32, 0xc6, 0xac, 0x15, 0x45, 0xda,
-	0x1e, 0x73, 0xea, 0x3f, 0xe4, 0x80, 0x4c, 0xb6, 0x9c, 0x64, 0x1b, 0x0a, 0x5d, 0xf4, 0xec, 0x61,
-	0x5c, 0x75, 0x1f, 0xce, 0xd9, 0xaa, 0x9a, 0x2f, 0x25, 0xc9, 0xd2, 0x5c, 0x69, 0xc4, 0xee, 0x24,
-	0xe5, 0xf1, 0x0a, 0x46, 0xb6, 0x24, 0xc9, 0xd2, 0xdc, 0xda, 0x97, 0x19, 0x28, 0x28, 0xab, 0xc4,
-	0x80, 0xc5, 0x00, 0xb9, 0x83, 0xbe, 0x88, 0x03, 0x25, 0x11, 0xc9, 0x53, 0xa8, 0x1c, 0xd1, 0x01,
-	0x76, 0xdb, 0x7a, 0xcd, 0x97, 0xc5, 0xc8, 0xce, 0x82, 0x05, 0x0a, 0xaf, 0xed, 0xee, 0x40, 0x55,
-	0x1e, 0x88, 0xaf, 0x5d, 0x12, 0xdb, 0xc8, 0x5d, 0x6e, 0x63, 0x25, 0xc5, 0x52, 0x96, 0x5a, 0x55,
-	0xb8, 0x2e, 0x1f, 0x97, 0xda, 0x84, 0x8a, 0xf5, 0xda, 0x27, 0x19, 0x28, 0xa8, 0xfd, 0xcc, 0x58,
-	0xfe, 0x3d, 0xa8, 0x28, 0x5a, 0xfc, 0xf2, 0x56, 0x37, 0x95, 0x5c, 0xa3, 0x54, 0xea, 0x17, 0xb7,
-	0x84, 0xb8, 0x3c, 0x70, 0x12, 0x48, 0x92, 0x5e, 0x20, 0x95, 0x63, 0x88, 0x24, 0x34, 0xdb, 0xa8,
-	0xde, 0x08, 0xf9, 0x04, 0xa2, 0x94, 0xaf, 0xa4, 0x4e, 0xa6, 0xa1, 0x1a, 0xd4, 0x69, 0xf8, 0x1a,
-	0x96, 0xd2, 0x8f, 0x48, 0x99, 0xd6, 0x7e, 0xd4, 0xef, 0x20, 0x57, 0xeb, 0x5b, 0x96, 0x69, 0xad,
-	0x65, 0xb2, 0x0a, 0x79, 0xdf, 0x8e, 0x7b, 0x39, 0x69, 0x53, 0x49, 0xad, 0xa2, 0x2e, 0xd1, 0xad,
-	0xbd, 0xcf, 0xbe, 0xbd, 0x93, 0xf9, 0xd7, 0x9b, 0x69, 0xff, 0x3e, 0x44, 0x01, 0x72, 0xd7, 0x63,
-	0xac, 0x11, 0x1c, 0xbb, 0xea, 0x2f, 0x08, 0x1c, 0x08, 0xe4, 0xbe, 0xed, 0x35, 0xd4, 0xf9, 0x37,
-	0xc6, 0x01, 0xd1, 0x38, 0xd9, 0xb0, 0xbd, 0xa0, 0x67, 0x3f, 0xea, 0x14, 0x95, 0xaf, 0x1f, 0xfd,
-	0x18, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x27, 0x08, 0xe1, 0x84, 0x11, 0x00, 0x00,
+	proto.RegisterFile("virtual_service.proto", fileDescriptor_virtual_service_bde8e79b7d8bbd4a)
+}
+
+var fileDescriptor_virtual_service_bde8e79b7d8bbd4a = []byte{
+	// 1584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xef, 0x6e, 0x23, 0x49,
+	0x11, 0x8f, 0xff, 0xc6, 0x2e, 0x27, 0xb9, 0xb8, 0x2f, 0xb7, 0xcc, 0x99, 0x63, 0x37, 0x6b, 0x74,
+	0x5c, 0x24, 0x74, 0x63, 0xc5, 0x81, 0x23, 0x2c, 0xbb, 0xcb, 0xc6, 0xd9, 0x3f, 0x59, 0x29, 0x11,
+	0xd1, 0x24, 0xbb, 0x20, 0xb4, 0x30, 0x1a, 0x8f, 0x2b, 0xe3, 0xde, 0x8c, 0xa7, 0x87, 0x9e, 0x9e,
+	0xc4, 0x7e, 0x01, 0x78, 0x05, 0xbe, 0xf0, 0x9d, 0x57, 0x40, 0xe2, 0x01, 0x78, 0x04, 0x24, 0xa4,
+	0xfd, 0xc0, 0x1b, 0x80, 0x84, 0xc4, 0x47, 0xd4, 0xdd, 0x33, 0xf6, 0x24, 0x76, 0x1c, 0x47, 0xec,
+	0x7d, 0xf2, 0x54, 0xf5, 0xef, 0x57, 0xdd, 0x5d, 0x5d, 0x55, 0x5d, 0x6d, 0xf8, 0xec, 0x82, 0x72,
+	0x11, 0x3b, 0xbe, 0x1d, 0x21, 0xbf, 0xa0, 0x2e, 0x9a, 0x21, 0x67, 0x82, 0x91, 0x4f, 0x03, 0x14,
+	0x97, 0x8c, 0x9f, 0xd3, 0xc0, 0x33, 0x69, 0x24, 0x28, 0x33, 0x29, 0x6b, 0xdc, 0xf7, 0x18, 0xf3,
+	0x7c, 0x6c, 0x29, 0x48, 0x37, 0x3e, 0x6b, 0xf5, 0x62, 0xee, 0x08, 0xca, 0x02, 0x4d, 0x9a, 0x1e,
+	0xbf, 0xe4, 0x4e, 0x18, 0x22, 0x8f, 0x92, 0xf1, 0x0d, 0x8f, 0x79, 0x4c, 0x7d, 0xb6, 0xe4, 0x57,
+	0xa2, 0xdd, 0xf6, 0xa8, 0xe8, 0xc7, 0x5d, 0xd3, 0x65, 0x83, 0x56, 0xc4, 0x7c, 0xf6, 0x35, 0x65,
+	0xfa, 0xf7, 0x9c, 0x8a, 0x96, 0x13, 0xd2, 0xd6, 0xc5, 0x76, 0x6b, 0x80, 0xc2, 0xe9, 0x39, 0xc2,
+	0x49, 0x28, 0xad, 0x05, 0x28, 0x91, 0x70, 0x44, 0x9c, 0xcc, 0xdc, 0xfc, 0x47, 0x1e, 0xd6, 0xde,
+	0xea, 0x8d, 0x9e, 0xe8, 0x7d, 0x92, 0x57, 0x50, 0xd6, 0x10, 0xa3, 0xbc, 0x99, 0xdb, 0xaa, 0xb5,
+	0x37, 0x4c, 0x97, 0x71, 0x34, 0xa5, 0x19, 0x93, 0x32, 0xf3, 0x44, 0x8d, 0x75, 0x3e, 0xff, 0xdb,
+	0x87, 0x07, 0x4b, 0xff, 0xfe, 0xf0, 0xa0, 0x2e, 0x30, 0x12, 0x3d, 0x7a, 0x76, 0xf6, 0xa8, 0x49,
+	0xbd, 0x80, 0x71, 0x6c, 0x5a, 0x09, 0x9d, 0xec, 0x42, 0x25, 0x5d, 0x9e, 0xb1, 0xac, 0x4c, 0xdd,
+	0xbb, 0x6a, 0xea, 0x28, 0x19, 0xed, 0x14, 0xa5, 0x31, 0x6b, 0x8c, 0x26, 0x1b, 0x50, 0xea, 0xb3,
+	0x48, 0x44, 0x46, 0x6e, 0xb3, 0xb0, 0x55, 0xb5, 0xb4, 0x40, 0x1a, 0x50, 0xf1, 0x1c, 0x81, 0x97,
+	0xce, 0x28, 0x32, 0xf2, 0x6a, 0x60, 0x2c, 0x93, 0x36, 0x14, 0xfb, 0x42, 0x84, 0x46, 0x61, 0xb3,
+	0xb0, 0x55, 0x6b, 0xdf, 0x37, 0x67, 0x9c, 0x92, 0x79, 0x70, 0x7a, 0x7a, 0x6c, 0xb1, 0x58, 0xa0,
+	0xa5, 0xb0, 0xa4, 0x05, 0x05, 0xe1, 0x47, 0x46, 0x49, 0x51, 0xbe, 0x37, 0x93, 0x72, 0x7a, 0x78,
+	0xa2, 0x19, 0x12, 0xa9, 0x08, 0x6e, 0x68, 0x14, 0xe7, 0x11, 0xf6, 0x8f, 0x53, 0x82, 0x1b, 0x36,
+	0x43, 0xa8, 0x3d, 0xc7, 0x48, 0xd0, 0x40, 0x05, 0x03, 0x21, 0x50, 0x94, 0x3b, 0x31, 0x72, 0x9b,
+	0xb9, 0xad, 0xaa, 0xa5, 0xbe, 0xc9, 0x3d, 0x28, 0x47, 0x71, 0x37, 0x42, 0x61, 0xe4, 0x95, 0x36,
+	0x91, 0xc8, 0x8f, 0xa1, 0x18, 0x32, 0x2e, 0x8c, 0x82, 0x72, 0xdc, 0xc3, 0x99, 0x93, 0x1d, 0x33,
+	0x2e, 0x4e, 0xd0, 0x47, 0x57, 0x30, 0x6e, 0x29, 0x78, 0xf3, 0x5f, 0x65, 0xa8, 0x8e, 0xf7, 0x49,
+	0x7e, 0x06, 0xa5, 0x81, 0x23, 0xdc, 0xbe, 0xf2, 0x63, 0xad, 0xfd, 0xe5, 0x8d, 0x6e, 0x39, 0x92,
+	0x28, 0x0b, 0x7f, 0x17, 0x63, 0x24, 0x2c, 0xcd, 0x21, 0x8f, 0xa1, 0xc4, 0xa5, 0x15, 0xe5, 0xeb,
+	0x5a, 0xfb, 0x07, 0x33, 0xc9, 0x99, 0xed, 0xfd, 0x12, 0xa9, 0xd7, 0x17, 0x96, 0x26, 0x91, 0x27,
+	0x50, 0xe1, 0xd8, 0xa3, 0x1c, 0xdd, 0xf9, 0x7b, 0x50, 0x8b, 0x4d, 0x80, 0xd6, 0x98, 0x42, 0x1e,
+	0xc1, 0x32, 0xc7, 0x4b, 0x4e, 0x05, 0x1a, 0x45, 0xc5, 0xde, 0x9c, 0xc3, 0x56, 0x38, 0x2b, 0x25,
+	0x90, 0x1f, 0x42, 0xfd, 0x12, 0xbb, 0x11, 0x73, 0xcf, 0x51, 0xd8, 0x71, 0xe8, 0x71, 0xa7, 0x87,
+	0x46, 0x69, 0x33, 0xb7, 0x55, 0xb1, 0xd6, 0xc7, 0x03, 0x6f, 0xb4, 0x9e, 0xec, 0xc0, 0xb2, 0xa0,
+	0x03, 0x64, 0xb1, 0x48, 0xc2, 0xfd, 0x73, 0x53, 0x27, 0xab, 0x99, 0x26, 0xab, 0xf9, 0x3c, 0x49,
+	0x66, 0x2b, 0x45, 0x92, 0x5d, 0xb9, 0x3a, 0xc1, 0x29, 0x46, 0x49, 0x60, 0xcf, 0x09, 0x38, 0x14,
+	0x7c, 0x64, 0xa5, 0x70, 0xf2, 0x04, 0x4a, 0x67, 0x4e, 0xec, 0x0b, 0xa3, 0xa2, 0x78, 0x5f, 0xdd,
+	0xc8, 0x7b, 0x29, 0x51, 0xaf, 0x83, 0xf7, 0xe8, 0xaa, 0xa9, 0x35, 0x8b, 0xec, 0x42, 0x79, 0x40,
+	0x39, 0x67, 0xdc, 0xa8, 0xce, 0xf1, 0x4a, 0xe6, 0x50, 0xac, 0x04, 0x4f, 0x9e, 0x41, 0xcd, 0x65,
+	0x3c, 0xb2, 0x43, 0xe6, 0x53, 0x77, 0x64, 0x80, 0xa2, 0x3f, 0x98, 0x49, 0xdf, 0x67, 0x3c, 0x3a,
+	0x56, 0x30, 0x0b, 0xdc, 0xf1, 0x37, 0xf9, 0x15, 0xac, 0xc9, 0xa2, 0x15, 0xf4, 0xec, 0x3e, 0x3a,
+	0x3d, 0xe4, 0x91, 0x51, 0x53, 0x81, 0xb1, 0x3d, 0x3f, 0xd9, 0xcc, 0x3d, 0x45, 0x3a, 0xd0, 0x9c,
+	0x17, 0x81, 0x74, 0xc7, 0xaa, 0x93, 0xd5, 0x91, 0x6f, 0xe0, 0x3b, 0x1c, 0x07, 0xec, 0x02, 0x6d,
+	0x8e, 0x51, 0xc8, 0x82, 0x08, 0xc7, 0x53, 0xac, 0xa8, 0x3c, 0xff, 0x4c, 0x0f, 0x5b, 0xc9, 0x68,
+	0xca, 0xdb, 0x87, 0x35, 0xbd, 0x3b, 0x3b, 0x44, 0xee, 0x62, 0x20, 0x0c, 0xa2, 0xb6, 0xf5, 0xc5,
+	0xd4, 0x11, 0xbe, 0x79, 0x1d, 0x88, 0x9d, 0xf6, 0x5b, 0xc7, 0x8f, 0xd1, 0x5a, 0xd5, 0x9c, 0x63,
+	0x4d, 0x69, 0x3c, 0x03, 0x32, 0xbd, 0x42, 0xb2, 0x0e, 0x85, 0x73, 0x1c, 0x25, 0x99, 0x2a, 0x3f,
+	0x65, 0x4d, 0xba, 0x90, 0xfc, 0x24, 0x4f, 0xb5, 0xf0, 0x28, 0xbf, 0x9b, 0x6b, 0xfe, 0x21, 0x07,
+	0x95, 0xb4, 0x50, 0xc8, 0x03, 0xce, 0xa6, 0xdc, 0x57, 0x37, 0x95, 0x15, 0x95, 0x71, 0x7b, 0x42,
+	0x70, 0xda, 0x8d, 0x05, 0x46, 0x1f, 0x25, 0xe9, 0x9a, 0xbf, 0x97, 0x2b, 0x49, 0x2a, 0x90, 0x34,
+	0x95, 0x5d, 0xc9, 0x6c, 0x53, 0x87, 0x3f, 0xfa, 0x56, 0x16, 0xf2, 0x9f, 0x22, 0xac, 0x5f, 0xaf,
+	0x2b, 0xa4, 0x0d, 0x85, 0x98, 0x53, 0x23, 0x37, 0x27, 0x72, 0x4f, 0x04, 0xa7, 0x81, 0xa7, 0x59,
+	0x12, 0x2c, 0x03, 0x3e, 0x72, 0xfb, 0x38, 0xd0, 0x6e, 0x5f, 0x84, 0x96, 0xe0, 0x55, 0xaa, 0xa0,
+	0xe8, 0xb3, 0x9e, 0x51, 0x58, 0x94, 0xa9, 0xf1, 0xe4, 0x29, 0x54, 0x9d, 0x58, 0xf4, 0x19, 0xa7,
+	0x62, 0x64, 0x14, 0x17, 0x24, 0x4f, 0x28, 0xe4, 0x10, 0x96, 0xd3, 0xf0, 0xd5, 0x77, 0x4b, 0x7b,
+	0xa1, 0xba, 0x6b, 0x5e, 0x49, 0x91, 0xd4, 0x84, 0xbc, 0x34, 0xd4, 0x45, 0x20, 0xab, 0xd3, 0xaa,
+	0xae, 0xf2, 0xe4, 0x1d, 0xac, 0x46, 0x2c, 0xe6, 0x2e, 0xda, 0xbe, 0xd3, 0x45, 0x5f, 0x56, 0x21,
+	0x39, 0xcf, 0x4f, 0x16, 0x9b, 0xe7, 0x44, 0x51, 0x0f, 0x15, 0x53, 0x4f, 0xb6, 0x12, 0x65, 0x54,
+	0x57, 0xee, 0xd9, 0xca, 0xd5, 0x7b, 0xb6, 0xf1, 0x0e, 0x56, 0x6e, 0xc9, 0x93, 0x6f, 0xb2, 0x79,
+	0xb2, 0x88, 0xe7, 0x26, 0x99, 0xd4, 0xf8, 0x39, 0xd4, 0xa7, 0x16, 0x77, 0xa7, 0x54, 0x64, 0x50,
+	0x9f, 0x8a, 0x49, 0xd2, 0x81, 0x5a, 0x6f, 0xa2, 0x9c, 0x1b, 0x7f, 0x19, 0xb2, 0x95, 0x25, 0xc9,
+	0x6b, 0xfa, 0x52, 0x59, 0x53, 0x73, 0x96, 0xac, 0x44, 0x6a, 0xfe, 0x25, 0x0f, 0xf5, 0xa9, 0x1c,
+	0x22, 0x2d, 0xf8, 0x34, 0x43, 0xb6, 0xa3, 0xb8, 0x1b, 0xe0, 0xb8, 0x9b, 0x21, 0x99, 0xa1, 0x13,
+	0x3d, 0x32, 0x3e, 0xe4, 0x7c, 0xe6, 0x90, 0xbf, 0x3f, 0x3e, 0x64, 0xcd, 0x57, 0x71, 0x5c, 0x4d,
+	0xcf, 0x4a, 0x33, 0xc9, 0x6f, 0xae, 0x47, 0x82, 0x6e, 0x4e, 0x76, 0x17, 0x4b, 0xf6, 0x3b, 0x85,
+	0x42, 0xe9, 0x5a, 0x28, 0xfc, 0xdf, 0x87, 0xf5, 0xf7, 0x3c, 0x90, 0xe9, 0x4a, 0x48, 0xbe, 0x0b,
+	0xd5, 0x28, 0xa0, 0x76, 0xb6, 0x01, 0xac, 0x44, 0x01, 0x3d, 0x90, 0xf2, 0x4d, 0x9e, 0xcd, 0xdf,
+	0xea, 0xd9, 0xc2, 0x3c, 0xcf, 0x16, 0x67, 0x78, 0xf6, 0xb7, 0xd7, 0x3d, 0xab, 0x73, 0xf9, 0xa7,
+	0x0b, 0x16, 0xf4, 0x3b, 0xb9, 0xb6, 0xfc, 0xb1, 0x5d, 0xfb, 0x14, 0x56, 0xb2, 0x8d, 0x15, 0x59,
+	0x9f, 0x94, 0xde, 0xaa, 0x2e, 0xac, 0x5f, 0x64, 0x8b, 0x9c, 0xe6, 0x4f, 0x14, 0xcd, 0x27, 0x50,
+	0xcb, 0xb4, 0x56, 0x77, 0xa6, 0x23, 0xd4, 0x32, 0x19, 0x4e, 0xee, 0x41, 0x09, 0x87, 0x8e, 0x9b,
+	0x34, 0xbe, 0x07, 0x4b, 0x96, 0x16, 0x89, 0x01, 0xe5, 0x90, 0xe3, 0x19, 0x1d, 0x6a, 0x0b, 0x07,
+	0x4b, 0x56, 0x22, 0x4b, 0x06, 0x47, 0x0f, 0x87, 0x46, 0x21, 0x65, 0x28, 0xb1, 0xb3, 0x02, 0xa0,
+	0xae, 0x27, 0x5b, 0x8c, 0x42, 0x6c, 0xbe, 0x87, 0xea, 0xb8, 0xc5, 0x92, 0xfe, 0x74, 0x84, 0xc0,
+	0x41, 0xa8, 0xa2, 0x46, 0xe6, 0xe8, 0x58, 0x26, 0x7b, 0xf0, 0x49, 0x88, 0xdc, 0x16, 0x7c, 0x64,
+	0xa7, 0xcd, 0x5e, 0xfe, 0xb6, 0x66, 0x6f, 0x35, 0x44, 0x7e, 0xca, 0x47, 0xa7, 0x1a, 0xdf, 0xfc,
+	0x53, 0x1e, 0x60, 0xd2, 0x18, 0x91, 0x87, 0xb0, 0xe2, 0xf8, 0x3e, 0xbb, 0xb4, 0x19, 0xa7, 0x1e,
+	0x0d, 0x92, 0x38, 0xad, 0x29, 0xdd, 0x2f, 0x94, 0x4a, 0x46, 0x99, 0x86, 0xe8, 0x6b, 0x25, 0x0d,
+	0x52, 0xcd, 0x3b, 0xd2, 0xba, 0x09, 0x28, 0xbd, 0x31, 0x0a, 0x19, 0x50, 0xda, 0xe7, 0x7c, 0x09,
+	0x6b, 0x38, 0x0c, 0x59, 0xa6, 0x2d, 0x2a, 0x2a, 0xd4, 0xaa, 0xd6, 0xa6, 0xb0, 0x36, 0x2c, 0x0f,
+	0x9c, 0xa1, 0xed, 0x78, 0xba, 0xdb, 0x9d, 0xbb, 0xbb, 0xf2, 0xc0, 0x19, 0xee, 0x79, 0xf2, 0xb1,
+	0x57, 0xd7, 0xf3, 0xbb, 0x1c, 0x7b, 0x18, 0x08, 0xea, 0xf8, 0xe9, 0xbb, 0xaf, 0x31, 0xc5, 0xee,
+	0x30, 0xe6, 0xeb, 0x1e, 0x6a, 0x5d, 0x91, 0xf6, 0x27, 0x9c, 0xe6, 0x7f, 0x0b, 0x40, 0xa6, 0xfb,
+	0x56, 0xb2, 0x0f, 0xa5, 0x1e, 0xfa, 0xce, 0x28, 0xa9, 0xba, 0x5f, 0x2f, 0xd8, 0xef, 0x9a, 0xcf,
+	0x25, 0xc9, 0xd2, 0x5c, 0x69, 0xc4, 0xe9, 0xa6, 0xe5, 0xf1, 0x0e, 0x46, 0xf6, 0x24, 0xc9, 0xd2,
+	0xdc, 0xc6, 0x5f, 0x73, 0x50, 0x52, 0x56, 0x89, 0x01, 0xcb, 0x69, 0xbf, 0xa8, 0x03, 0x25, 0x15,
+	0xc9, 0x63, 0xa8, 0x9d, 0xd1, 0x21, 0xf6, 0x6c, 0xbd, 0xe6, 0xdb, 0x62, 0xe4, 0x60, 0xc9, 0x02,
+	0x85, 0xd7, 0x76, 0x0f, 0xa0, 0x2e, 0x0f, 0x24, 0xd0, 0x2e, 0x49, 0x6c, 0x14, 0x6e, 0xb7, 0xb1,
+	0x9e, 0x61, 0x29, 0x4b, 0x9d, 0x3a, 0x7c, 0x22, 0x5f, 0xa8, 0xda, 0x84, 0x8a, 0xf5, 0xc6, 0x1f,
+	0x73, 0x50, 0x52, 0xfb, 0x99, 0xb3, 0xfc, 0x87, 0x50, 0x53, 0xb4, 0xe4, 0xf9, 0xae, 0x6e, 0x2a,
+	0xb9, 0x46, 0xa9, 0xd4, 0xcf, 0x76, 0x09, 0xf1, 0x78, 0xe8, 0xa6, 0x90, 0x34, 0xbd, 0x40, 0x2a,
+	0x27, 0x10, 0x49, 0x68, 0xdb, 0xa8, 0x1e, 0x1a, 0xc5, 0x14, 0xa2, 0x94, 0x2f, 0xa4, 0x4e, 0xa6,
+	0xa1, 0x1a, 0xd4, 0x69, 0xf8, 0x12, 0x56, 0xb2, 0x2f, 0x51, 0x99, 0xd6, 0x41, 0x3c, 0xe8, 0x22,
+	0x57, 0xeb, 0x5b, 0x95, 0x69, 0xad, 0x65, 0xb2, 0x01, 0xc5, 0xc0, 0x49, 0x7a, 0x39, 0x69, 0x53,
+	0x49, 0x9d, 0xb2, 0x2e, 0xd1, 0x9d, 0xa3, 0x3f, 0xff, 0xf3, 0x7e, 0xee, 0xd7, 0xaf, 0x66, 0xfd,
+	0x85, 0x11, 0x87, 0xc8, 0x3d, 0x9f, 0xb1, 0x56, 0x78, 0xee, 0xa9, 0xff, 0x31, 0x70, 0x28, 0x90,
+	0x07, 0x8e, 0xdf, 0x52, 0xe7, 0xdf, 0x9a, 0x04, 0x44, 0xeb, 0x62, 0xdb, 0xf1, 0xc3, 0xbe, 0xb3,
+	0xd3, 0x2d, 0x2b, 0x5f, 0xef, 0xfc, 0x6f, 0x00, 0xe2, 0x69, 0x3b, 0xb2, 0xc9, 0x11, 0x00, 0x00,
 }
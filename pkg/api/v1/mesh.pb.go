@@ -22,7 +22,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
-//
 // @solo-kit:resource.short_name=mesh
 // @solo-kit:resource.plural_name=meshes
 // @solo-kit:resource.resource_groups=translator.supergloo.solo.io
@@ -41,9 +40,12 @@ type Mesh struct {
 	MeshType isMesh_MeshType `protobuf_oneof:"mesh_type"`
 	// policy applied to the mesh
 	// TODO: rick-ducott, yuval-k: consider splitting these out as in routing.proto
-	Encryption           *Encryption    `protobuf:"bytes,98,opt,name=encryption" json:"encryption,omitempty"`
-	Observability        *Observability `protobuf:"bytes,99,opt,name=observability" json:"observability,omitempty"`
-	Policy               *Policy        `protobuf:"bytes,100,opt,name=policy" json:"policy,omitempty"`
+	Encryption    *Encryption    `protobuf:"bytes,98,opt,name=encryption" json:"encryption,omitempty"`
+	Observability *Observability `protobuf:"bytes,99,opt,name=observability" json:"observability,omitempty"`
+	Policy        *Policy        `protobuf:"bytes,100,opt,name=policy" json:"policy,omitempty"`
+	// the default load balancing, connection pool and outlier detection settings
+	// for every destination in the mesh. routing rules can override them for their destinations
+	TrafficPolicy        *TrafficPolicy `protobuf:"bytes,101,opt,name=traffic_policy,json=trafficPolicy" json:"traffic_policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -53,7 +55,7 @@ func (m *Mesh) Reset()         { *m = Mesh{} }
 func (m *Mesh) String() string { return proto.CompactTextString(m) }
 func (*Mesh) ProtoMessage()    {}
 func (*Mesh) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mesh_3338b27d75633b9e, []int{0}
+	return fileDescriptor_mesh_5056954840d16585, []int{0}
 }
 func (m *Mesh) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Mesh.Unmarshal(m, b)
@@ -155,6 +157,13 @@ func (m *Mesh) GetPolicy() *Policy {
 	return nil
 }
 
+func (m *Mesh) GetTrafficPolicy() *TrafficPolicy {
+	if m != nil {
+		return m.TrafficPolicy
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Mesh) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Mesh_OneofMarshaler, _Mesh_OneofUnmarshaler, _Mesh_OneofSizer, []interface{}{
@@ -266,7 +275,7 @@ func (m *Istio) Reset()         { *m = Istio{} }
 func (m *Istio) String() string { return proto.CompactTextString(m) }
 func (*Istio) ProtoMessage()    {}
 func (*Istio) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mesh_3338b27d75633b9e, []int{1}
+	return fileDescriptor_mesh_5056954840d16585, []int{1}
 }
 func (m *Istio) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Istio.Unmarshal(m, b)
@@ -325,7 +334,7 @@ func (m *Linkerd2) Reset()         { *m = Linkerd2{} }
 func (m *Linkerd2) String() string { return proto.CompactTextString(m) }
 func (*Linkerd2) ProtoMessage()    {}
 func (*Linkerd2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mesh_3338b27d75633b9e, []int{2}
+	return fileDescriptor_mesh_5056954840d16585, []int{2}
 }
 func (m *Linkerd2) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Linkerd2.Unmarshal(m, b)
@@ -374,7 +383,10 @@ type Consul struct {
 	ServerAddress string `protobuf:"bytes,2,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
 	// if provided, this will give Supergloo a reference to the prometheus configuration associated with this consul install
 	// if empty, Supergloo will look for the configmap `linkerd.prometheus`
-	PrometheusConfigmap  *core.ResourceRef `protobuf:"bytes,3,opt,name=prometheus_configmap,json=prometheusConfigmap" json:"prometheus_configmap,omitempty"`
+	PrometheusConfigmap *core.ResourceRef `protobuf:"bytes,3,opt,name=prometheus_configmap,json=prometheusConfigmap" json:"prometheus_configmap,omitempty"`
+	// reference to the kubernetes secret holding the ACL token supergloo uses with the consul api, under the key `token`.
+	// if empty, no token is sent
+	AclTokenSecret       *core.ResourceRef `protobuf:"bytes,4,opt,name=acl_token_secret,json=aclTokenSecret" json:"acl_token_secret,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -384,7 +396,7 @@ func (m *Consul) Reset()         { *m = Consul{} }
 func (m *Consul) String() string { return proto.CompactTextString(m) }
 func (*Consul) ProtoMessage()    {}
 func (*Consul) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mesh_3338b27d75633b9e, []int{3}
+	return fileDescriptor_mesh_5056954840d16585, []int{3}
 }
 func (m *Consul) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Consul.Unmarshal(m, b)
@@ -425,6 +437,13 @@ func (m *Consul) GetPrometheusConfigmap() *core.ResourceRef {
 	return nil
 }
 
+func (m *Consul) GetAclTokenSecret() *core.ResourceRef {
+	if m != nil {
+		return m.AclTokenSecret
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mesh)(nil), "supergloo.solo.io.Mesh")
 	proto.RegisterType((*Istio)(nil), "supergloo.solo.io.Istio")
@@ -474,6 +493,9 @@ func (this *Mesh) Equal(that interface{}) bool {
 	if !this.Policy.Equal(that1.Policy) {
 		return false
 	}
+	if !this.TrafficPolicy.Equal(that1.TrafficPolicy) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -655,49 +677,55 @@ func (this *Consul) Equal(that interface{}) bool {
 	if !this.PrometheusConfigmap.Equal(that1.PrometheusConfigmap) {
 		return false
 	}
+	if !this.AclTokenSecret.Equal(that1.AclTokenSecret) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
 
-func init() { proto.RegisterFile("mesh.proto", fileDescriptor_mesh_3338b27d75633b9e) }
-
-var fileDescriptor_mesh_3338b27d75633b9e = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xdf, 0x6a, 0xd4, 0x4c,
-	0x14, 0xc0, 0x37, 0x5f, 0xdb, 0x7c, 0xdd, 0xa9, 0xd5, 0x76, 0xba, 0x96, 0x69, 0xc5, 0x76, 0x59,
-	0x10, 0x57, 0xb0, 0x89, 0xdb, 0x22, 0xa8, 0xe0, 0x85, 0x5b, 0xd4, 0x15, 0x5a, 0x95, 0xf1, 0xce,
-	0x9b, 0x65, 0x36, 0x99, 0xcd, 0x0e, 0x9b, 0xe4, 0x84, 0x99, 0x49, 0x65, 0xdf, 0xc8, 0x07, 0x10,
-	0x04, 0x9f, 0xc0, 0xa7, 0xe8, 0x85, 0x8f, 0xd0, 0x27, 0x90, 0x4c, 0x26, 0xfb, 0x07, 0x57, 0x28,
-	0xe2, 0x8d, 0x57, 0x09, 0xe7, 0xfc, 0x7e, 0x27, 0x27, 0x73, 0x0e, 0x83, 0x50, 0xc2, 0xd5, 0xc8,
-	0xcb, 0x24, 0x68, 0xc0, 0xdb, 0x2a, 0xcf, 0xb8, 0x8c, 0x62, 0x00, 0x4f, 0x41, 0x0c, 0x9e, 0x80,
-	0xfd, 0x46, 0x04, 0x11, 0x98, 0xac, 0x5f, 0xbc, 0x95, 0xe0, 0x7e, 0x27, 0x12, 0x7a, 0x94, 0x0f,
-	0xbc, 0x00, 0x12, 0xbf, 0x20, 0x8f, 0x04, 0x94, 0xcf, 0xb1, 0xd0, 0x3e, 0xcb, 0x84, 0x7f, 0xd1,
-	0xf1, 0x13, 0xae, 0x59, 0xc8, 0x34, 0xb3, 0x8a, 0x7f, 0x0d, 0x45, 0x69, 0xa6, 0x73, 0x65, 0x85,
-	0x87, 0xd7, 0x10, 0x24, 0x1f, 0x5a, 0x7a, 0x07, 0x06, 0x8a, 0xcb, 0x0b, 0x36, 0x10, 0xb1, 0xd0,
-	0x13, 0x1b, 0xdc, 0xe2, 0x69, 0x20, 0x27, 0x99, 0x16, 0x90, 0xda, 0xc8, 0x8d, 0x0c, 0x62, 0x11,
-	0xd8, 0x7c, 0xeb, 0x6a, 0x05, 0xad, 0x9e, 0x73, 0x35, 0xc2, 0xaf, 0x91, 0x5b, 0x7e, 0x9b, 0xb8,
-	0x4d, 0xa7, 0xbd, 0x71, 0xdc, 0xf0, 0x02, 0x90, 0xbc, 0x3a, 0x04, 0xef, 0x83, 0xc9, 0x75, 0xf7,
-	0xbe, 0x5f, 0x1e, 0xd6, 0xae, 0x2e, 0x0f, 0xb7, 0x35, 0x57, 0x3a, 0x14, 0xc3, 0xe1, 0xb3, 0x96,
-	0x88, 0x52, 0x90, 0xbc, 0x45, 0xad, 0x8e, 0x9f, 0xa0, 0xf5, 0xea, 0xbf, 0xc9, 0xff, 0xa6, 0xd4,
-	0xee, 0x62, 0xa9, 0x73, 0x9b, 0xed, 0xae, 0x16, 0xc5, 0xe8, 0x94, 0xc6, 0x8f, 0xd0, 0x9a, 0x50,
-	0x5a, 0x00, 0x41, 0x46, 0x23, 0xde, 0x2f, 0xb3, 0xf0, 0xde, 0x14, 0xf9, 0x5e, 0x8d, 0x96, 0x20,
-	0x7e, 0x8a, 0xd6, 0x63, 0x91, 0x8e, 0xb9, 0x0c, 0x8f, 0x49, 0xc3, 0x48, 0x77, 0x96, 0x48, 0x67,
-	0x16, 0xe9, 0xd5, 0xe8, 0x14, 0xc7, 0x27, 0xc8, 0x0d, 0x20, 0x55, 0x79, 0x4c, 0x0e, 0x8c, 0xb8,
-	0xb7, 0x44, 0x3c, 0x35, 0x40, 0xaf, 0x46, 0x2d, 0x8a, 0x9f, 0x23, 0x34, 0x3b, 0x4f, 0x32, 0x30,
-	0xe2, 0xdd, 0x25, 0xe2, 0xcb, 0x29, 0x44, 0xe7, 0x04, 0xfc, 0x0a, 0x6d, 0x2e, 0xcc, 0x88, 0x04,
-	0xa6, 0x42, 0x73, 0x49, 0x85, 0x77, 0xf3, 0x1c, 0x5d, 0xd4, 0x70, 0x07, 0xb9, 0xe5, 0x10, 0x49,
-	0xf8, 0xdb, 0xde, 0xdf, 0x1b, 0x80, 0x5a, 0xb0, 0xbb, 0x81, 0xea, 0xc5, 0x96, 0xf7, 0xf5, 0x24,
-	0xe3, 0xad, 0xaf, 0x0e, 0x5a, 0x33, 0x27, 0x89, 0x1f, 0xa3, 0x5d, 0x91, 0x2a, 0xcd, 0xe2, 0x98,
-	0x15, 0x1d, 0xf6, 0x53, 0x96, 0x70, 0x95, 0xb1, 0x80, 0x13, 0xa7, 0xe9, 0xb4, 0xeb, 0xf4, 0xf6,
-	0x7c, 0xf6, 0x6d, 0x95, 0xc4, 0x0f, 0xd0, 0xd6, 0x27, 0xa6, 0x83, 0xd1, 0x8c, 0x57, 0xe4, 0xbf,
-	0xe6, 0x4a, 0xbb, 0x4e, 0x6f, 0x99, 0xf8, 0x94, 0x54, 0xf8, 0x0c, 0x35, 0x32, 0x09, 0x09, 0xd7,
-	0x23, 0x9e, 0xab, 0x7e, 0x00, 0xe9, 0x50, 0x44, 0x09, 0xcb, 0xc8, 0x8a, 0xed, 0x7c, 0x61, 0x35,
-	0x28, 0x57, 0x90, 0xcb, 0x80, 0x53, 0x3e, 0xa4, 0x3b, 0x33, 0xed, 0xb4, 0xb2, 0x5a, 0xdf, 0x1c,
-	0xb4, 0x5e, 0x8d, 0xf3, 0x9f, 0x6b, 0xfe, 0x8b, 0x83, 0xdc, 0x72, 0xa5, 0xfe, 0xb4, 0xf5, 0x7b,
-	0xe8, 0x66, 0xb1, 0x07, 0x5c, 0xf6, 0x59, 0x18, 0x4a, 0xae, 0x8a, 0xc6, 0x0b, 0x7c, 0xb3, 0x8c,
-	0xbe, 0x28, 0x83, 0x7f, 0xb7, 0xed, 0xee, 0xd1, 0xe7, 0x1f, 0x07, 0xce, 0xc7, 0xfb, 0xcb, 0xee,
-	0xa2, 0x6a, 0xeb, 0xfc, 0x6c, 0x1c, 0xd9, 0x0b, 0x69, 0xe0, 0x9a, 0x8b, 0xe5, 0xe4, 0x67, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x79, 0x4d, 0x82, 0x09, 0x56, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("mesh.proto", fileDescriptor_mesh_5056954840d16585) }
+
+var fileDescriptor_mesh_5056954840d16585 = []byte{
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0x80, 0x5b, 0x29, 0x15, 0x06, 0x8b, 0x30, 0x54, 0x32, 0x60, 0x84, 0xa6, 0x89, 0x11, 0x13,
+	0xd9, 0x15, 0x88, 0x89, 0x9a, 0x78, 0xb0, 0x44, 0xc1, 0x04, 0xd4, 0x0c, 0x9c, 0xbc, 0x6c, 0xa6,
+	0xd3, 0xb7, 0xdb, 0x49, 0xb7, 0x3b, 0x9b, 0x99, 0x59, 0x0c, 0xff, 0xc8, 0x7f, 0x60, 0xa2, 0x7f,
+	0xc0, 0x5f, 0xc1, 0xc1, 0x9f, 0xe0, 0xcd, 0x9b, 0xd9, 0xd9, 0xd9, 0xd2, 0xc6, 0x12, 0x89, 0xf1,
+	0xe2, 0xa9, 0xdd, 0xf7, 0xbe, 0xef, 0xf5, 0x75, 0xde, 0xdb, 0x41, 0x68, 0x08, 0xba, 0xef, 0xa5,
+	0x4a, 0x1a, 0x89, 0x97, 0x75, 0x96, 0x82, 0x8a, 0x62, 0x29, 0x3d, 0x2d, 0x63, 0xe9, 0x09, 0xb9,
+	0xde, 0x8c, 0x64, 0x24, 0x6d, 0xd6, 0xcf, 0xbf, 0x15, 0xe0, 0xfa, 0x4e, 0x24, 0x4c, 0x3f, 0xeb,
+	0x7a, 0x5c, 0x0e, 0xfd, 0x9c, 0xdc, 0x16, 0xb2, 0xf8, 0x1c, 0x08, 0xe3, 0xb3, 0x54, 0xf8, 0x67,
+	0x3b, 0xfe, 0x10, 0x0c, 0xeb, 0x31, 0xc3, 0x9c, 0xe2, 0x5f, 0x43, 0xd1, 0x86, 0x99, 0x4c, 0x3b,
+	0xe1, 0xd1, 0x35, 0x04, 0x05, 0xa1, 0xa3, 0x57, 0x64, 0x57, 0x83, 0x3a, 0x63, 0x5d, 0x11, 0x0b,
+	0x73, 0xee, 0x82, 0x4b, 0x90, 0x70, 0x75, 0x9e, 0x1a, 0x21, 0x13, 0x17, 0xb9, 0x95, 0xca, 0x58,
+	0xf0, 0x32, 0xdf, 0x50, 0x32, 0x33, 0x22, 0x89, 0x8a, 0xc7, 0xf6, 0xd7, 0x1a, 0xaa, 0x1d, 0x83,
+	0xee, 0xe3, 0x03, 0x54, 0x2f, 0x5a, 0x21, 0xf5, 0x56, 0x75, 0x6b, 0x61, 0xb7, 0xe9, 0x71, 0xa9,
+	0xa0, 0x3c, 0x13, 0xef, 0xc4, 0xe6, 0x3a, 0x6b, 0xdf, 0x2e, 0x36, 0x2b, 0x3f, 0x2e, 0x36, 0x97,
+	0x0d, 0x68, 0xd3, 0x13, 0x61, 0xf8, 0xbc, 0x2d, 0xa2, 0x44, 0x2a, 0x68, 0x53, 0xa7, 0xe3, 0xa7,
+	0x68, 0xae, 0x3c, 0x06, 0x72, 0xd3, 0x96, 0x5a, 0x9d, 0x2c, 0x75, 0xec, 0xb2, 0x9d, 0x5a, 0x5e,
+	0x8c, 0x8e, 0x68, 0xfc, 0x18, 0xcd, 0x0a, 0x6d, 0x84, 0x24, 0xc8, 0x6a, 0xc4, 0xfb, 0x6d, 0x34,
+	0xde, 0x9b, 0x3c, 0x7f, 0x58, 0xa1, 0x05, 0x88, 0x9f, 0xa1, 0xb9, 0x58, 0x24, 0x03, 0x50, 0xbd,
+	0x5d, 0xd2, 0xb4, 0xd2, 0xdd, 0x29, 0xd2, 0x91, 0x43, 0x0e, 0x2b, 0x74, 0x84, 0xe3, 0x3d, 0x54,
+	0xe7, 0x32, 0xd1, 0x59, 0x4c, 0x36, 0xac, 0xb8, 0x36, 0x45, 0xdc, 0xb7, 0xc0, 0x61, 0x85, 0x3a,
+	0x14, 0xbf, 0x40, 0xe8, 0xf2, 0x78, 0x49, 0xd7, 0x8a, 0xf7, 0xa6, 0x88, 0xaf, 0x46, 0x10, 0x1d,
+	0x13, 0xf0, 0x6b, 0xd4, 0x98, 0x18, 0x19, 0xe1, 0xb6, 0x42, 0x6b, 0x4a, 0x85, 0x77, 0xe3, 0x1c,
+	0x9d, 0xd4, 0xf0, 0x0e, 0xaa, 0x17, 0x33, 0x25, 0xbd, 0x2b, 0x7b, 0x7f, 0x6f, 0x01, 0xea, 0x40,
+	0x7c, 0x80, 0x16, 0x8d, 0x62, 0x61, 0x28, 0x78, 0xe0, 0x54, 0xb8, 0xf2, 0xb7, 0x4f, 0x0b, 0xd0,
+	0x55, 0x68, 0x98, 0xf1, 0xc7, 0xce, 0x02, 0x9a, 0xcf, 0xdf, 0x9e, 0xc0, 0x9c, 0xa7, 0xd0, 0xfe,
+	0x5c, 0x45, 0xb3, 0x76, 0x24, 0xf8, 0x09, 0x5a, 0x15, 0x89, 0x36, 0x2c, 0x8e, 0x59, 0xfe, 0x57,
+	0x83, 0x84, 0x0d, 0x41, 0xa7, 0x8c, 0x03, 0xa9, 0xb6, 0xaa, 0x5b, 0xf3, 0xf4, 0xce, 0x78, 0xf6,
+	0x6d, 0x99, 0xc4, 0x0f, 0xd1, 0xd2, 0x47, 0x66, 0x78, 0xff, 0x92, 0xd7, 0xe4, 0x46, 0x6b, 0x66,
+	0x6b, 0x9e, 0xde, 0xb6, 0xf1, 0x11, 0xa9, 0xf1, 0x11, 0x6a, 0xa6, 0x4a, 0x0e, 0xc1, 0xf4, 0x21,
+	0xd3, 0x01, 0x97, 0x49, 0x28, 0xa2, 0x21, 0x4b, 0xc9, 0x8c, 0x3b, 0x82, 0x89, 0x1d, 0xa3, 0xa0,
+	0x65, 0xa6, 0x38, 0x50, 0x08, 0xe9, 0xca, 0xa5, 0xb6, 0x5f, 0x5a, 0xed, 0x2f, 0x55, 0x34, 0x57,
+	0xee, 0xc5, 0x7f, 0xd7, 0xfc, 0xcf, 0x2a, 0xaa, 0x17, 0xbb, 0xf9, 0xb7, 0xad, 0xdf, 0x47, 0x8b,
+	0xf9, 0x42, 0x81, 0x0a, 0x58, 0xaf, 0xa7, 0x40, 0xe7, 0x8d, 0xe7, 0x78, 0xa3, 0x88, 0xbe, 0x2c,
+	0x82, 0xff, 0xb6, 0x6d, 0xbc, 0x8f, 0x96, 0x18, 0x8f, 0x03, 0x23, 0x07, 0x90, 0x04, 0x1a, 0xb8,
+	0x02, 0x43, 0x6a, 0x7f, 0xaa, 0xb4, 0xc8, 0x78, 0x7c, 0x9a, 0x1b, 0x27, 0x56, 0xe8, 0x6c, 0x7f,
+	0xfa, 0xbe, 0x51, 0xfd, 0xf0, 0x60, 0xda, 0x45, 0x59, 0x2e, 0xb2, 0x9f, 0x0e, 0x22, 0x77, 0x5b,
+	0x76, 0xeb, 0xf6, 0x9a, 0xdb, 0xfb, 0x35, 0x00, 0x4c, 0x73, 0xdd, 0xa2, 0xf3, 0x05, 0x00, 0x00,
 }
@@ -37,7 +37,7 @@ func (m *Observability) Reset()         { *m = Observability{} }
 func (m *Observability) String() string { return proto.CompactTextString(m) }
 func (*Observability) ProtoMessage()    {}
 func (*Observability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_observability_2cc66680e940db77, []int{0}
+	return fileDescriptor_observability_4502f80e0e72b7e5, []int{0}
 }
 func (m *Observability) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Observability.Unmarshal(m, b)
@@ -69,7 +69,10 @@ type Prometheus struct {
 	// kubernetes only. if specified, pods with these labels in the namespace will be restarted by supergloo
 	PodLabels map[string]string `protobuf:"bytes,2,rep,name=pod_labels,json=podLabels" json:"pod_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// enable prometheus scraping for metrics of this mesh type
-	EnableMetrics        bool     `protobuf:"varint,3,opt,name=enable_metrics,json=enableMetrics,proto3" json:"enable_metrics,omitempty"`
+	EnableMetrics bool `protobuf:"varint,3,opt,name=enable_metrics,json=enableMetrics,proto3" json:"enable_metrics,omitempty"`
+	// address of the prometheus server queried by supergloo, e.g. for the analysis of canaries.
+	// canaries can override it with their own prometheus url
+	Url                  string   `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -79,7 +82,7 @@ func (m *Prometheus) Reset()         { *m = Prometheus{} }
 func (m *Prometheus) String() string { return proto.CompactTextString(m) }
 func (*Prometheus) ProtoMessage()    {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_observability_2cc66680e940db77, []int{1}
+	return fileDescriptor_observability_4502f80e0e72b7e5, []int{1}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Prometheus.Unmarshal(m, b)
@@ -113,6 +116,13 @@ func (m *Prometheus) GetEnableMetrics() bool {
 	return false
 }
 
+func (m *Prometheus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Observability)(nil), "supergloo.solo.io.Observability")
 	proto.RegisterType((*Prometheus)(nil), "supergloo.solo.io.Prometheus")
@@ -175,35 +185,38 @@ func (this *Prometheus) Equal(that interface{}) bool {
 	if this.EnableMetrics != that1.EnableMetrics {
 		return false
 	}
+	if this.Url != that1.Url {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
 
-func init() { proto.RegisterFile("observability.proto", fileDescriptor_observability_2cc66680e940db77) }
-
-var fileDescriptor_observability_2cc66680e940db77 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x4d, 0x4f, 0x3a, 0x31,
-	0x10, 0xc6, 0xb3, 0x90, 0xff, 0x3f, 0x52, 0x02, 0xd1, 0x85, 0xc3, 0x66, 0x13, 0x09, 0x21, 0x31,
-	0x72, 0x80, 0x36, 0xe2, 0xc5, 0x18, 0xbd, 0x98, 0x78, 0xf2, 0x8d, 0xec, 0xd1, 0x0b, 0x69, 0x61,
-	0x28, 0x0d, 0x85, 0x69, 0xda, 0x2e, 0xca, 0x37, 0xf2, 0x53, 0xf8, 0x61, 0xfc, 0x24, 0x66, 0x77,
-	0x41, 0x31, 0xbe, 0x9c, 0x76, 0x66, 0x9e, 0xdf, 0xce, 0xf4, 0x79, 0x48, 0x03, 0x85, 0x03, 0xbb,
-	0xe2, 0x42, 0x69, 0xe5, 0xd7, 0xd4, 0x58, 0xf4, 0x18, 0x1e, 0xb8, 0xd4, 0x80, 0x95, 0x1a, 0x91,
-	0x3a, 0xd4, 0x48, 0x15, 0xc6, 0x4d, 0x89, 0x12, 0x73, 0x95, 0x65, 0x55, 0x01, 0xc6, 0x2d, 0x89,
-	0x28, 0x35, 0xb0, 0xbc, 0x13, 0xe9, 0x94, 0x4d, 0x52, 0xcb, 0xbd, 0xc2, 0xe5, 0x6f, 0xfa, 0x93,
-	0xe5, 0xc6, 0x80, 0x75, 0x1b, 0xbd, 0x91, 0xdd, 0x60, 0xab, 0x93, 0x0c, 0x78, 0xde, 0x5c, 0x8f,
-	0x7b, 0x52, 0xf9, 0x59, 0x2a, 0xe8, 0x18, 0x17, 0x2c, 0x3b, 0xdf, 0x57, 0x58, 0x7c, 0xe7, 0xca,
-	0x33, 0x6e, 0x54, 0xc6, 0x5b, 0x98, 0x16, 0x74, 0xe7, 0x9e, 0xd4, 0x1e, 0x76, 0x2d, 0x84, 0x97,
-	0x84, 0x18, 0x8b, 0x0b, 0xf0, 0x33, 0x48, 0x5d, 0x14, 0xb4, 0x83, 0x6e, 0x75, 0x70, 0x48, 0xbf,
-	0x39, 0xa2, 0xc3, 0x0f, 0x28, 0xd9, 0xf9, 0xa1, 0xf3, 0x1a, 0x10, 0xf2, 0x29, 0x85, 0x37, 0x84,
-	0x18, 0x9c, 0x8c, 0x34, 0x17, 0xa0, 0x5d, 0x54, 0x6a, 0x97, 0xbb, 0xd5, 0x41, 0xef, 0xcf, 0x6d,
-	0x74, 0x88, 0x93, 0xdb, 0x1c, 0xbf, 0x5e, 0x7a, 0xbb, 0x4e, 0x2a, 0x66, 0xdb, 0x87, 0x47, 0xa4,
-	0x0e, 0x4b, 0x2e, 0x34, 0x8c, 0x16, 0xe0, 0xad, 0x1a, 0xbb, 0xa8, 0xdc, 0x0e, 0xba, 0x7b, 0x49,
-	0xad, 0x98, 0xde, 0x15, 0xc3, 0xf8, 0x82, 0xd4, 0xbf, 0xee, 0x08, 0xf7, 0x49, 0x79, 0x0e, 0xeb,
-	0xdc, 0x4c, 0x25, 0xc9, 0xca, 0xb0, 0x49, 0xfe, 0xad, 0xb8, 0x4e, 0x21, 0x2a, 0xe5, 0xb3, 0xa2,
-	0x39, 0x2f, 0x9d, 0x05, 0x57, 0xfd, 0x97, 0xb7, 0x56, 0xf0, 0x78, 0xfc, 0x53, 0x88, 0xdb, 0x57,
-	0x33, 0x33, 0x97, 0x9b, 0x24, 0xc5, 0xff, 0x3c, 0xc6, 0xd3, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0x6c, 0x27, 0x1a, 0x09, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("observability.proto", fileDescriptor_observability_4502f80e0e72b7e5) }
+
+var fileDescriptor_observability_4502f80e0e72b7e5 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcd, 0x4e, 0x2a, 0x31,
+	0x14, 0xc7, 0x33, 0x70, 0xef, 0xcd, 0xa5, 0x04, 0xa2, 0x03, 0x8b, 0xc9, 0x24, 0x92, 0x09, 0x89,
+	0x71, 0x16, 0x30, 0x8d, 0xb8, 0x31, 0x46, 0x37, 0x26, 0xae, 0xfc, 0x22, 0xb3, 0x74, 0x43, 0x5a,
+	0x28, 0xa5, 0xa1, 0x70, 0x9a, 0x7e, 0xa0, 0xbc, 0x91, 0x8f, 0xe3, 0x33, 0xf8, 0x24, 0xa6, 0x33,
+	0xa0, 0x18, 0x3f, 0x56, 0x3d, 0xe7, 0xfc, 0x7f, 0x3d, 0x27, 0xff, 0x3f, 0x6a, 0x01, 0x35, 0x4c,
+	0xaf, 0x08, 0x15, 0x52, 0xd8, 0x75, 0xa6, 0x34, 0x58, 0x08, 0xf7, 0x8d, 0x53, 0x4c, 0x73, 0x09,
+	0x90, 0x19, 0x90, 0x90, 0x09, 0x88, 0xdb, 0x1c, 0x38, 0x14, 0x2a, 0xf6, 0x55, 0x09, 0xc6, 0x1d,
+	0x0e, 0xc0, 0x25, 0xc3, 0x45, 0x47, 0xdd, 0x14, 0x4f, 0x9c, 0x26, 0x56, 0xc0, 0xf2, 0x27, 0xfd,
+	0x51, 0x13, 0xa5, 0x98, 0x36, 0x1b, 0xbd, 0xe5, 0x6f, 0xe0, 0xd5, 0xb1, 0x07, 0x9e, 0x36, 0xd7,
+	0xe3, 0x1e, 0x17, 0x76, 0xe6, 0x68, 0x36, 0x86, 0x05, 0xf6, 0xe7, 0xfb, 0x02, 0xca, 0x77, 0x2e,
+	0x2c, 0x26, 0x4a, 0x78, 0x5e, 0xb3, 0x69, 0x49, 0x77, 0xef, 0x50, 0xe3, 0x7e, 0xd7, 0x42, 0x78,
+	0x81, 0x90, 0xd2, 0xb0, 0x60, 0x76, 0xc6, 0x9c, 0x89, 0x82, 0x24, 0x48, 0xeb, 0x83, 0x83, 0xec,
+	0x8b, 0xa3, 0x6c, 0xf8, 0x0e, 0xe5, 0x3b, 0x1f, 0xba, 0x2f, 0x01, 0x42, 0x1f, 0x52, 0x78, 0x8d,
+	0x90, 0x82, 0xc9, 0x48, 0x12, 0xca, 0xa4, 0x89, 0x2a, 0x49, 0x35, 0xad, 0x0f, 0x7a, 0xbf, 0x6e,
+	0xcb, 0x86, 0x30, 0xb9, 0x29, 0xf0, 0xab, 0xa5, 0xd5, 0xeb, 0xbc, 0xa6, 0xb6, 0x7d, 0x78, 0x88,
+	0x9a, 0x6c, 0x49, 0xa8, 0x64, 0xa3, 0x05, 0xb3, 0x5a, 0x8c, 0x4d, 0x54, 0x4d, 0x82, 0xf4, 0x7f,
+	0xde, 0x28, 0xa7, 0xb7, 0xe5, 0x30, 0xdc, 0x43, 0x55, 0xa7, 0x65, 0xf4, 0x27, 0x09, 0xd2, 0x5a,
+	0xee, 0xcb, 0xf8, 0x1c, 0x35, 0x3f, 0x6f, 0xf5, 0xcc, 0x9c, 0xad, 0x0b, 0x7b, 0xb5, 0xdc, 0x97,
+	0x61, 0x1b, 0xfd, 0x5d, 0x11, 0xe9, 0x58, 0x54, 0x29, 0x66, 0x65, 0x73, 0x56, 0x39, 0x0d, 0x2e,
+	0xfb, 0xcf, 0xaf, 0x9d, 0xe0, 0xe1, 0xe8, 0xbb, 0x58, 0xb7, 0x3e, 0xb0, 0x9a, 0xf3, 0x4d, 0xb6,
+	0xf4, 0x5f, 0x11, 0xec, 0xc9, 0xdb, 0x00, 0x06, 0x41, 0x34, 0x07, 0x1b, 0x02, 0x00, 0x00,
 }
@@ -22,8 +22,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type Policy_Mode int32
+
+const (
+	// the requests which are not allowed by the rules are denied
+	Policy_ENFORCED Policy_Mode = 0
+	// dry run: the requests which are not allowed by the rules are reported, but not denied.
+	// meshes which cannot report them reject the policy, and do not enforce it
+	Policy_PERMISSIVE Policy_Mode = 1
+	// the policy is not enforced, all requests are allowed
+	Policy_OFF Policy_Mode = 2
+)
+
+var Policy_Mode_name = map[int32]string{
+	0: "ENFORCED",
+	1: "PERMISSIVE",
+	2: "OFF",
+}
+var Policy_Mode_value = map[string]int32{
+	"ENFORCED":   0,
+	"PERMISSIVE": 1,
+	"OFF":        2,
+}
+
+func (x Policy_Mode) String() string {
+	return proto.EnumName(Policy_Mode_name, int32(x))
+}
+func (Policy_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_policy_d336d69e27326454, []int{0, 0}
+}
+
 type Policy struct {
-	Rules                []*Rule  `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	// how the policy is enforced, ENFORCED by default
+	Mode Policy_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=supergloo.solo.io.Policy_Mode" json:"mode,omitempty"`
+	// the namespaces of the destination services the policy is enforced for, all of them if empty.
+	// the requests to the services in the other namespaces are all allowed
+	Namespaces []string `protobuf:"bytes,4,rep,name=namespaces" json:"namespaces,omitempty"`
+	Rules      []*Rule  `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	// the requests denied by these rules are denied even if they are allowed by the other rules
+	DenyRules            []*Rule  `protobuf:"bytes,5,rep,name=deny_rules,json=denyRules" json:"deny_rules,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -33,7 +70,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_f5cbd493936bdad4, []int{0}
+	return fileDescriptor_policy_d336d69e27326454, []int{0}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policy.Unmarshal(m, b)
@@ -53,6 +90,20 @@ func (m *Policy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Policy proto.InternalMessageInfo
 
+func (m *Policy) GetMode() Policy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return Policy_ENFORCED
+}
+
+func (m *Policy) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 func (m *Policy) GetRules() []*Rule {
 	if m != nil {
 		return m.Rules
@@ -60,19 +111,36 @@ func (m *Policy) GetRules() []*Rule {
 	return nil
 }
 
+func (m *Policy) GetDenyRules() []*Rule {
+	if m != nil {
+		return m.DenyRules
+	}
+	return nil
+}
+
+// Rule allows the requests from the source upstream to the destination upstream, or denies them if it is
+// one of the deny rules of the policy.
+// The requests can be restricted further by their method, path and port. Meshes which cannot
+// express these restrictions reject the rules which use them, and report it on the status of the mesh
 type Rule struct {
-	Source               *core.ResourceRef `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
-	Destination          *core.ResourceRef `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Source      *core.ResourceRef `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	Destination *core.ResourceRef `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
+	// the HTTP methods of the allowed requests, e.g. `GET`. all methods are allowed if empty
+	Methods []string `protobuf:"bytes,3,rep,name=methods" json:"methods,omitempty"`
+	// the prefixes of the paths of the allowed requests, e.g. `/api/`. all paths are allowed if empty
+	PathPrefixes []string `protobuf:"bytes,4,rep,name=path_prefixes,json=pathPrefixes" json:"path_prefixes,omitempty"`
+	// the destination ports of the allowed requests. all ports are allowed if empty
+	Ports                []uint32 `protobuf:"varint,5,rep,packed,name=ports" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
 func (m *Rule) String() string { return proto.CompactTextString(m) }
 func (*Rule) ProtoMessage()    {}
 func (*Rule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_f5cbd493936bdad4, []int{1}
+	return fileDescriptor_policy_d336d69e27326454, []int{1}
 }
 func (m *Rule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rule.Unmarshal(m, b)
@@ -106,9 +174,116 @@ func (m *Rule) GetDestination() *core.ResourceRef {
 	return nil
 }
 
+func (m *Rule) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *Rule) GetPathPrefixes() []string {
+	if m != nil {
+		return m.PathPrefixes
+	}
+	return nil
+}
+
+func (m *Rule) GetPorts() []uint32 {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+// A MeshPolicy holds policy rules for its target mesh, so that each team can own the rules for its services
+// in its own namespace rather than editing the mesh. The rules of all the mesh policies targeting a mesh are
+// merged with the rules of the policy of the mesh. Its mode and namespaces apply to all of them. A mesh without
+// a policy enforces them in ENFORCED mode for the namespaces of its mesh policies only. The rules of a mesh policy
+// only apply to the services in its namespace, it is rejected if the destination of one of its rules is elsewhere.
+// @solo-kit:resource.short_name=meshpolicy
+// @solo-kit:resource.plural_name=meshpolicies
+// @solo-kit:resource.resource_groups=translator.supergloo.solo.io
+type MeshPolicy struct {
+	// Status indicates the validation status of this resource.
+	// Status is read-only by clients, and set by supergloo during validation
+	Status core.Status `protobuf:"bytes,100,opt,name=status" json:"status" testdiff:"ignore"`
+	// Metadata contains the object metadata for this resource
+	Metadata core.Metadata `protobuf:"bytes,101,opt,name=metadata" json:"metadata"`
+	// the mesh the rules are applied to
+	TargetMesh *core.ResourceRef `protobuf:"bytes,1,opt,name=target_mesh,json=targetMesh" json:"target_mesh,omitempty"`
+	// the rules allowing requests, as in the policy of a mesh
+	Rules []*Rule `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	// the rules denying requests, as in the policy of a mesh
+	DenyRules            []*Rule  `protobuf:"bytes,3,rep,name=deny_rules,json=denyRules" json:"deny_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeshPolicy) Reset()         { *m = MeshPolicy{} }
+func (m *MeshPolicy) String() string { return proto.CompactTextString(m) }
+func (*MeshPolicy) ProtoMessage()    {}
+func (*MeshPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_d336d69e27326454, []int{2}
+}
+func (m *MeshPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeshPolicy.Unmarshal(m, b)
+}
+func (m *MeshPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeshPolicy.Marshal(b, m, deterministic)
+}
+func (dst *MeshPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeshPolicy.Merge(dst, src)
+}
+func (m *MeshPolicy) XXX_Size() int {
+	return xxx_messageInfo_MeshPolicy.Size(m)
+}
+func (m *MeshPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeshPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeshPolicy proto.InternalMessageInfo
+
+func (m *MeshPolicy) GetStatus() core.Status {
+	if m != nil {
+		return m.Status
+	}
+	return core.Status{}
+}
+
+func (m *MeshPolicy) GetMetadata() core.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return core.Metadata{}
+}
+
+func (m *MeshPolicy) GetTargetMesh() *core.ResourceRef {
+	if m != nil {
+		return m.TargetMesh
+	}
+	return nil
+}
+
+func (m *MeshPolicy) GetRules() []*Rule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *MeshPolicy) GetDenyRules() []*Rule {
+	if m != nil {
+		return m.DenyRules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Policy)(nil), "supergloo.solo.io.Policy")
 	proto.RegisterType((*Rule)(nil), "supergloo.solo.io.Rule")
+	proto.RegisterType((*MeshPolicy)(nil), "supergloo.solo.io.MeshPolicy")
+	proto.RegisterEnum("supergloo.solo.io.Policy_Mode", Policy_Mode_name, Policy_Mode_value)
 }
 func (this *Policy) Equal(that interface{}) bool {
 	if that == nil {
@@ -129,6 +304,17 @@ func (this *Policy) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
 	if len(this.Rules) != len(that1.Rules) {
 		return false
 	}
@@ -137,6 +323,14 @@ func (this *Policy) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DenyRules) != len(that1.DenyRules) {
+		return false
+	}
+	for i := range this.DenyRules {
+		if !this.DenyRules[i].Equal(that1.DenyRules[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -167,29 +361,119 @@ func (this *Rule) Equal(that interface{}) bool {
 	if !this.Destination.Equal(that1.Destination) {
 		return false
 	}
+	if len(this.Methods) != len(that1.Methods) {
+		return false
+	}
+	for i := range this.Methods {
+		if this.Methods[i] != that1.Methods[i] {
+			return false
+		}
+	}
+	if len(this.PathPrefixes) != len(that1.PathPrefixes) {
+		return false
+	}
+	for i := range this.PathPrefixes {
+		if this.PathPrefixes[i] != that1.PathPrefixes[i] {
+			return false
+		}
+	}
+	if len(this.Ports) != len(that1.Ports) {
+		return false
+	}
+	for i := range this.Ports {
+		if this.Ports[i] != that1.Ports[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MeshPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MeshPolicy)
+	if !ok {
+		that2, ok := that.(MeshPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Status.Equal(&that1.Status) {
+		return false
+	}
+	if !this.Metadata.Equal(&that1.Metadata) {
+		return false
+	}
+	if !this.TargetMesh.Equal(that1.TargetMesh) {
+		return false
+	}
+	if len(this.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range this.Rules {
+		if !this.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	if len(this.DenyRules) != len(that1.DenyRules) {
+		return false
+	}
+	for i := range this.DenyRules {
+		if !this.DenyRules[i].Equal(that1.DenyRules[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
 
-func init() { proto.RegisterFile("policy.proto", fileDescriptor_policy_f5cbd493936bdad4) }
-
-var fileDescriptor_policy_f5cbd493936bdad4 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xc8, 0xcf, 0xc9,
-	0x4c, 0xae, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2c, 0x2e, 0x2d, 0x48, 0x2d, 0x4a,
-	0xcf, 0xc9, 0xcf, 0xd7, 0x2b, 0xce, 0xcf, 0xc9, 0xd7, 0xcb, 0xcc, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58, 0x10, 0x85, 0x52, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x20, 0x95, 0xba, 0x99, 0xf9, 0x10, 0x3a, 0x3b, 0xb3, 0x44, 0x3f,
-	0xb1, 0x20, 0x53, 0xbf, 0xcc, 0x50, 0xbf, 0x28, 0x35, 0x0d, 0xa2, 0x5a, 0xc9, 0x9c, 0x8b, 0x2d,
-	0x00, 0x6c, 0x8d, 0x90, 0x2e, 0x17, 0x6b, 0x51, 0x69, 0x4e, 0x6a, 0xb1, 0x04, 0x93, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0xb8, 0x1e, 0x86, 0x85, 0x7a, 0x41, 0xa5, 0x39, 0xa9, 0x41, 0x10, 0x55, 0x4a,
-	0x65, 0x5c, 0x2c, 0x20, 0xae, 0x90, 0x21, 0x17, 0x5b, 0x71, 0x7e, 0x69, 0x51, 0x72, 0xaa, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa4, 0x5e, 0x72, 0x7e, 0x51, 0x2a, 0x42, 0x4b, 0x2a, 0x44,
-	0x36, 0x28, 0x35, 0x2d, 0x08, 0xaa, 0x50, 0xc8, 0x9a, 0x8b, 0x3b, 0x25, 0xb5, 0xb8, 0x24, 0x33,
-	0x2f, 0xb1, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x89, 0x90, 0x3e, 0x64, 0xd5, 0x4e, 0xba, 0x2b, 0x1e,
-	0xc9, 0x31, 0x46, 0xa9, 0x63, 0xf3, 0x24, 0xcc, 0xbd, 0xfa, 0x05, 0xd9, 0xe9, 0x50, 0x9f, 0x26,
-	0xb1, 0x81, 0xbd, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x66, 0x8c, 0xf7, 0x02, 0x4d, 0x01,
-	0x00, 0x00,
+func init() { proto.RegisterFile("policy.proto", fileDescriptor_policy_d336d69e27326454) }
+
+var fileDescriptor_policy_d336d69e27326454 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0xc7, 0x63, 0x0c, 0x24, 0x19, 0x48, 0x44, 0x56, 0xe8, 0xfb, 0x9c, 0x1c, 0x08, 0x72, 0x0f,
+	0xe5, 0x50, 0x6c, 0x41, 0xa5, 0xaa, 0x4a, 0x6f, 0xb4, 0x50, 0xe5, 0x40, 0x83, 0x16, 0xa9, 0x87,
+	0x5e, 0x90, 0x83, 0x07, 0xb3, 0x0a, 0x66, 0xac, 0xdd, 0x75, 0xd5, 0xbc, 0x51, 0xcf, 0x7d, 0x8a,
+	0x4a, 0x7d, 0x87, 0x1c, 0xfa, 0x08, 0x79, 0x82, 0xca, 0x5e, 0x93, 0x26, 0x6a, 0xd4, 0x20, 0xf5,
+	0xe4, 0xdd, 0x99, 0xdf, 0x7f, 0x3c, 0x33, 0x3b, 0x03, 0xf5, 0x84, 0x56, 0x62, 0x7e, 0xed, 0x25,
+	0x92, 0x34, 0xb1, 0x23, 0x95, 0x26, 0x28, 0xa3, 0x15, 0x91, 0xa7, 0x68, 0x45, 0x9e, 0xa0, 0x93,
+	0x66, 0x44, 0x11, 0xe5, 0x5e, 0x3f, 0x3b, 0x19, 0xf0, 0xa4, 0x17, 0x09, 0xbd, 0x4c, 0x2f, 0xbd,
+	0x39, 0xc5, 0x7e, 0x46, 0x76, 0x05, 0x99, 0xef, 0x95, 0xd0, 0x7e, 0x90, 0x08, 0xff, 0x73, 0xcf,
+	0x8f, 0x51, 0x07, 0x61, 0xa0, 0x83, 0x42, 0xe2, 0x6f, 0x21, 0x51, 0x3a, 0xd0, 0xa9, 0x2a, 0x04,
+	0x2f, 0xb6, 0x10, 0x48, 0x5c, 0x18, 0xda, 0xbd, 0xb5, 0xa0, 0x3a, 0xc9, 0x6b, 0x61, 0x7d, 0x28,
+	0xc7, 0x14, 0xa2, 0x63, 0xb7, 0xad, 0xce, 0x61, 0xbf, 0xe5, 0xfd, 0x51, 0x94, 0x67, 0x40, 0x6f,
+	0x4c, 0x21, 0xf2, 0x9c, 0x65, 0x2d, 0x80, 0x75, 0x10, 0xa3, 0x4a, 0x82, 0x39, 0x2a, 0xa7, 0xdc,
+	0xb6, 0x3b, 0xfb, 0xfc, 0x9e, 0x85, 0x75, 0xa1, 0x22, 0xd3, 0x15, 0x2a, 0xa7, 0xd4, 0xb6, 0x3b,
+	0xb5, 0xfe, 0xff, 0x8f, 0x04, 0xe5, 0xe9, 0x0a, 0xb9, 0xa1, 0xd8, 0x2b, 0x80, 0x10, 0xd7, 0xd7,
+	0x33, 0xa3, 0xa9, 0xfc, 0x5d, 0xb3, 0x9f, 0xa1, 0xd9, 0x49, 0xb9, 0x5d, 0x28, 0x67, 0x49, 0xb1,
+	0x3a, 0xec, 0x0d, 0x3f, 0x8c, 0x2e, 0xf8, 0xdb, 0xe1, 0xbb, 0xc6, 0x0e, 0x3b, 0x04, 0x98, 0x0c,
+	0xf9, 0xf8, 0x7c, 0x3a, 0x3d, 0xff, 0x38, 0x6c, 0x58, 0x6c, 0x17, 0xec, 0x8b, 0xd1, 0xa8, 0x51,
+	0x72, 0x7f, 0x58, 0x50, 0xce, 0x84, 0xac, 0x07, 0x55, 0x45, 0xa9, 0x9c, 0xa3, 0x63, 0xb5, 0xad,
+	0x4e, 0xad, 0x7f, 0xec, 0xcd, 0x49, 0xe2, 0xef, 0xdf, 0xa0, 0xf1, 0x72, 0x5c, 0xf0, 0x02, 0x64,
+	0x6f, 0xa0, 0x16, 0xa2, 0xd2, 0x62, 0x1d, 0x68, 0x41, 0x6b, 0xa7, 0xf4, 0x94, 0xee, 0x3e, 0xcd,
+	0x1c, 0xd8, 0x8d, 0x51, 0x2f, 0x29, 0x54, 0x8e, 0x9d, 0xf7, 0x6a, 0x73, 0x65, 0xcf, 0xe0, 0x20,
+	0x09, 0xf4, 0x72, 0x96, 0x48, 0x5c, 0x88, 0x2f, 0x77, 0xbd, 0xac, 0x67, 0xc6, 0x49, 0x61, 0x63,
+	0x4d, 0xa8, 0x24, 0x24, 0xb5, 0xe9, 0xcc, 0x01, 0x37, 0x17, 0xf7, 0x5b, 0x09, 0x60, 0x8c, 0x6a,
+	0x59, 0x3c, 0xe3, 0x7b, 0xa8, 0x9a, 0x79, 0x70, 0xc2, 0x3c, 0xb7, 0xe6, 0xc3, 0xdc, 0xa6, 0xb9,
+	0x6f, 0x70, 0xfc, 0xfd, 0xe6, 0x74, 0xe7, 0xf6, 0xe6, 0xf4, 0x48, 0xa3, 0xd2, 0xa1, 0x58, 0x2c,
+	0xce, 0x5c, 0x11, 0xad, 0x49, 0xa2, 0xcb, 0x0b, 0x39, 0x7b, 0x0d, 0x7b, 0x9b, 0x59, 0x74, 0x30,
+	0x0f, 0xf5, 0xdf, 0xc3, 0x50, 0xe3, 0xc2, 0x3b, 0x28, 0x67, 0xc1, 0xf8, 0x1d, 0xcd, 0xce, 0xa0,
+	0xa6, 0x03, 0x19, 0xa1, 0x9e, 0xc5, 0xa8, 0x96, 0x4f, 0xf7, 0x16, 0x0c, 0x9d, 0x15, 0xf1, 0x6f,
+	0x13, 0x63, 0x6f, 0x3b, 0x31, 0x83, 0xee, 0xd7, 0x9f, 0x2d, 0xeb, 0xd3, 0xf3, 0xc7, 0x76, 0x65,
+	0xa3, 0xf5, 0x93, 0xab, 0xa8, 0x58, 0x98, 0xcb, 0x6a, 0xbe, 0x2d, 0x2f, 0x7f, 0x0d, 0x00, 0xef,
+	0x4b, 0x91, 0x7a, 0xf8, 0x03, 0x00, 0x00,
 }
@@ -39,8 +39,12 @@ type RoutingRule struct {
 	TargetMesh *core.ResourceRef `protobuf:"bytes,1,opt,name=target_mesh,json=targetMesh" json:"target_mesh,omitempty"`
 	// source upstreams to apply the rule to. if empty, applies to all sources.
 	Sources []*core.ResourceRef `protobuf:"bytes,2,rep,name=sources" json:"sources,omitempty"`
+	// selects additional source upstreams rather than listing them in sources
+	SourceSelector *UpstreamSelector `protobuf:"bytes,17,opt,name=source_selector,json=sourceSelector" json:"source_selector,omitempty"`
 	// destination upstreams for which this rule applies. if empty, applies to all destinations
 	Destinations []*core.ResourceRef `protobuf:"bytes,3,rep,name=destinations" json:"destinations,omitempty"`
+	// selects additional destination upstreams rather than listing them in destinations
+	DestinationSelector *UpstreamSelector `protobuf:"bytes,18,opt,name=destination_selector,json=destinationSelector" json:"destination_selector,omitempty"`
 	// if specified, this rule will only apply to http requests in the mesh matching these parameters
 	RequestMatchers []*v1.Matcher `protobuf:"bytes,4,rep,name=request_matchers,json=requestMatchers" json:"request_matchers,omitempty"`
 	// configuration to enable traffic shifting, e.g. by percentage or for alternate destinations
@@ -57,19 +61,33 @@ type RoutingRule struct {
 	CorsPolicy *v1alpha3.CorsPolicy `protobuf:"bytes,10,opt,name=cors_policy,json=corsPolicy" json:"cors_policy,omitempty"`
 	// Mirror HTTP traffic to a another destination for this rule. Traffic will still be sent
 	// to its original destination as normal.
-	Mirror *v1.Destination `protobuf:"bytes,9,opt,name=mirror" json:"mirror,omitempty"`
+	Mirror *Mirror `protobuf:"bytes,9,opt,name=mirror" json:"mirror,omitempty"`
 	// manipulate request and response headers for this rule
-	HeaderManipulaition  *HeaderManipulation `protobuf:"bytes,12,opt,name=header_manipulaition,json=headerManipulaition" json:"header_manipulaition,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	HeaderManipulaition *HeaderManipulation `protobuf:"bytes,12,opt,name=header_manipulaition,json=headerManipulaition" json:"header_manipulaition,omitempty"`
+	// if specified, this rule will route tcp connections rather than http requests.
+	// only traffic shifting can be combined with tcp routing, the http features of this rule must be empty
+	TcpRouting *TcpRouting `protobuf:"bytes,13,opt,name=tcp_routing,json=tcpRouting" json:"tcp_routing,omitempty"`
+	// if specified, this rule will route tls connections by their SNI rather than http requests.
+	// only traffic shifting can be combined with tls routing, the http features of this rule must be empty
+	TlsRouting *TlsRouting `protobuf:"bytes,14,opt,name=tls_routing,json=tlsRouting" json:"tls_routing,omitempty"`
+	// load balancing, connection pool and outlier detection settings for the destinations of this rule.
+	// each setting overrides the one in the traffic policy of the target mesh
+	TrafficPolicy *TrafficPolicy `protobuf:"bytes,15,opt,name=traffic_policy,json=trafficPolicy" json:"traffic_policy,omitempty"`
+	// rules with the same sources, destinations and matchers are merged. when they define different
+	// values for the same feature, the value of the rule with the highest priority is applied,
+	// and between rules with the same priority, the value of the first rule by namespace and name.
+	// conflicts are reported on the status of both rules until they are resolved
+	Priority             uint32   `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RoutingRule) Reset()         { *m = RoutingRule{} }
 func (m *RoutingRule) String() string { return proto.CompactTextString(m) }
 func (*RoutingRule) ProtoMessage()    {}
 func (*RoutingRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_routing_4812d946645e7c0f, []int{0}
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{0}
 }
 func (m *RoutingRule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RoutingRule.Unmarshal(m, b)
@@ -117,6 +135,13 @@ func (m *RoutingRule) GetSources() []*core.ResourceRef {
 	return nil
 }
 
+func (m *RoutingRule) GetSourceSelector() *UpstreamSelector {
+	if m != nil {
+		return m.SourceSelector
+	}
+	return nil
+}
+
 func (m *RoutingRule) GetDestinations() []*core.ResourceRef {
 	if m != nil {
 		return m.Destinations
@@ -124,6 +149,13 @@ func (m *RoutingRule) GetDestinations() []*core.ResourceRef {
 	return nil
 }
 
+func (m *RoutingRule) GetDestinationSelector() *UpstreamSelector {
+	if m != nil {
+		return m.DestinationSelector
+	}
+	return nil
+}
+
 func (m *RoutingRule) GetRequestMatchers() []*v1.Matcher {
 	if m != nil {
 		return m.RequestMatchers
@@ -166,7 +198,7 @@ func (m *RoutingRule) GetCorsPolicy() *v1alpha3.CorsPolicy {
 	return nil
 }
 
-func (m *RoutingRule) GetMirror() *v1.Destination {
+func (m *RoutingRule) GetMirror() *Mirror {
 	if m != nil {
 		return m.Mirror
 	}
@@ -180,6 +212,94 @@ func (m *RoutingRule) GetHeaderManipulaition() *HeaderManipulation {
 	return nil
 }
 
+func (m *RoutingRule) GetTcpRouting() *TcpRouting {
+	if m != nil {
+		return m.TcpRouting
+	}
+	return nil
+}
+
+func (m *RoutingRule) GetTlsRouting() *TlsRouting {
+	if m != nil {
+		return m.TlsRouting
+	}
+	return nil
+}
+
+func (m *RoutingRule) GetTrafficPolicy() *TrafficPolicy {
+	if m != nil {
+		return m.TrafficPolicy
+	}
+	return nil
+}
+
+func (m *RoutingRule) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// selects the upstreams of kubernetes services by their labels, their namespace or their service.
+// an upstream is selected when it matches every field which is set.
+// a rule whose selector does not match any upstream is rejected rather than applied to every upstream
+type UpstreamSelector struct {
+	// selects the upstreams whose pod selector contains all of these labels
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// selects the upstreams of the services in any of these namespaces
+	Namespaces []string `protobuf:"bytes,2,rep,name=namespaces" json:"namespaces,omitempty"`
+	// selects every upstream of these services, i.e. the upstreams for each of their ports and subsets
+	Services             []*core.ResourceRef `protobuf:"bytes,3,rep,name=services" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpstreamSelector) Reset()         { *m = UpstreamSelector{} }
+func (m *UpstreamSelector) String() string { return proto.CompactTextString(m) }
+func (*UpstreamSelector) ProtoMessage()    {}
+func (*UpstreamSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{1}
+}
+func (m *UpstreamSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpstreamSelector.Unmarshal(m, b)
+}
+func (m *UpstreamSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpstreamSelector.Marshal(b, m, deterministic)
+}
+func (dst *UpstreamSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpstreamSelector.Merge(dst, src)
+}
+func (m *UpstreamSelector) XXX_Size() int {
+	return xxx_messageInfo_UpstreamSelector.Size(m)
+}
+func (m *UpstreamSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpstreamSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpstreamSelector proto.InternalMessageInfo
+
+func (m *UpstreamSelector) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *UpstreamSelector) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *UpstreamSelector) GetServices() []*core.ResourceRef {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
 // enable traffic shifting for any http requests sent to one of the destinations on this rule
 type TrafficShifting struct {
 	// split traffic between these subsets based on their weights
@@ -194,7 +314,7 @@ func (m *TrafficShifting) Reset()         { *m = TrafficShifting{} }
 func (m *TrafficShifting) String() string { return proto.CompactTextString(m) }
 func (*TrafficShifting) ProtoMessage()    {}
 func (*TrafficShifting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_routing_4812d946645e7c0f, []int{1}
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{2}
 }
 func (m *TrafficShifting) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrafficShifting.Unmarshal(m, b)
@@ -236,7 +356,7 @@ func (m *WeightedDestination) Reset()         { *m = WeightedDestination{} }
 func (m *WeightedDestination) String() string { return proto.CompactTextString(m) }
 func (*WeightedDestination) ProtoMessage()    {}
 func (*WeightedDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_routing_4812d946645e7c0f, []int{2}
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{3}
 }
 func (m *WeightedDestination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WeightedDestination.Unmarshal(m, b)
@@ -270,6 +390,304 @@ func (m *WeightedDestination) GetWeight() uint32 {
 	return 0
 }
 
+// mirror the http requests of a rule to another upstream, in addition to sending them to their destination.
+// the responses of the mirror are ignored
+type Mirror struct {
+	// the upstream receiving the mirrored requests
+	Upstream *core.ResourceRef `protobuf:"bytes,1,opt,name=upstream" json:"upstream,omitempty"`
+	// percentage of the requests to mirror, between 0 and 100. all requests are mirrored if not set
+	Percentage           *types.UInt32Value `protobuf:"bytes,3,opt,name=percentage" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Mirror) Reset()         { *m = Mirror{} }
+func (m *Mirror) String() string { return proto.CompactTextString(m) }
+func (*Mirror) ProtoMessage()    {}
+func (*Mirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{4}
+}
+func (m *Mirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Mirror.Unmarshal(m, b)
+}
+func (m *Mirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Mirror.Marshal(b, m, deterministic)
+}
+func (dst *Mirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mirror.Merge(dst, src)
+}
+func (m *Mirror) XXX_Size() int {
+	return xxx_messageInfo_Mirror.Size(m)
+}
+func (m *Mirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mirror proto.InternalMessageInfo
+
+func (m *Mirror) GetUpstream() *core.ResourceRef {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *Mirror) GetPercentage() *types.UInt32Value {
+	if m != nil {
+		return m.Percentage
+	}
+	return nil
+}
+
+// settings for the connections to a destination: how requests are balanced across its instances,
+// how many connections and requests it accepts, and when unhealthy instances are ejected (circuit breaking)
+type TrafficPolicy struct {
+	// the load balancing algorithm
+	LoadBalancer *v1alpha3.LoadBalancerSettings `protobuf:"bytes,1,opt,name=load_balancer,json=loadBalancer" json:"load_balancer,omitempty"`
+	// limits on the connections and requests to each instance
+	ConnectionPool *v1alpha3.ConnectionPoolSettings `protobuf:"bytes,2,opt,name=connection_pool,json=connectionPool" json:"connection_pool,omitempty"`
+	// ejects instances that keep failing from the load balancing pool
+	OutlierDetection     *v1alpha3.OutlierDetection `protobuf:"bytes,3,opt,name=outlier_detection,json=outlierDetection" json:"outlier_detection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *TrafficPolicy) Reset()         { *m = TrafficPolicy{} }
+func (m *TrafficPolicy) String() string { return proto.CompactTextString(m) }
+func (*TrafficPolicy) ProtoMessage()    {}
+func (*TrafficPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{5}
+}
+func (m *TrafficPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficPolicy.Unmarshal(m, b)
+}
+func (m *TrafficPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficPolicy.Marshal(b, m, deterministic)
+}
+func (dst *TrafficPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficPolicy.Merge(dst, src)
+}
+func (m *TrafficPolicy) XXX_Size() int {
+	return xxx_messageInfo_TrafficPolicy.Size(m)
+}
+func (m *TrafficPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficPolicy proto.InternalMessageInfo
+
+func (m *TrafficPolicy) GetLoadBalancer() *v1alpha3.LoadBalancerSettings {
+	if m != nil {
+		return m.LoadBalancer
+	}
+	return nil
+}
+
+func (m *TrafficPolicy) GetConnectionPool() *v1alpha3.ConnectionPoolSettings {
+	if m != nil {
+		return m.ConnectionPool
+	}
+	return nil
+}
+
+func (m *TrafficPolicy) GetOutlierDetection() *v1alpha3.OutlierDetection {
+	if m != nil {
+		return m.OutlierDetection
+	}
+	return nil
+}
+
+// route tcp connections sent to the destinations of a rule
+type TcpRouting struct {
+	// if specified, this rule will only apply to tcp connections matching these parameters
+	Matchers             []*TcpMatcher `protobuf:"bytes,1,rep,name=matchers" json:"matchers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TcpRouting) Reset()         { *m = TcpRouting{} }
+func (m *TcpRouting) String() string { return proto.CompactTextString(m) }
+func (*TcpRouting) ProtoMessage()    {}
+func (*TcpRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{6}
+}
+func (m *TcpRouting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TcpRouting.Unmarshal(m, b)
+}
+func (m *TcpRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TcpRouting.Marshal(b, m, deterministic)
+}
+func (dst *TcpRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TcpRouting.Merge(dst, src)
+}
+func (m *TcpRouting) XXX_Size() int {
+	return xxx_messageInfo_TcpRouting.Size(m)
+}
+func (m *TcpRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_TcpRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TcpRouting proto.InternalMessageInfo
+
+func (m *TcpRouting) GetMatchers() []*TcpMatcher {
+	if m != nil {
+		return m.Matchers
+	}
+	return nil
+}
+
+// parameters for matching tcp connections
+type TcpMatcher struct {
+	// IPv4 or IPv6 ip addresses of the destination with optional subnet, e.g. a.b.c.d/xx or just a.b.c.d
+	DestinationSubnets []string `protobuf:"bytes,1,rep,name=destination_subnets,json=destinationSubnets" json:"destination_subnets,omitempty"`
+	// the port on the destination being addressed. if empty, applies to every port
+	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TcpMatcher) Reset()         { *m = TcpMatcher{} }
+func (m *TcpMatcher) String() string { return proto.CompactTextString(m) }
+func (*TcpMatcher) ProtoMessage()    {}
+func (*TcpMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{7}
+}
+func (m *TcpMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TcpMatcher.Unmarshal(m, b)
+}
+func (m *TcpMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TcpMatcher.Marshal(b, m, deterministic)
+}
+func (dst *TcpMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TcpMatcher.Merge(dst, src)
+}
+func (m *TcpMatcher) XXX_Size() int {
+	return xxx_messageInfo_TcpMatcher.Size(m)
+}
+func (m *TcpMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_TcpMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TcpMatcher proto.InternalMessageInfo
+
+func (m *TcpMatcher) GetDestinationSubnets() []string {
+	if m != nil {
+		return m.DestinationSubnets
+	}
+	return nil
+}
+
+func (m *TcpMatcher) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+// route tls connections sent to the destinations of a rule.
+// the connections are routed on their SNI without being terminated
+type TlsRouting struct {
+	// tls connections are routed only when they match one of these. at least one matcher is required
+	Matchers             []*TlsMatcher `protobuf:"bytes,1,rep,name=matchers" json:"matchers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TlsRouting) Reset()         { *m = TlsRouting{} }
+func (m *TlsRouting) String() string { return proto.CompactTextString(m) }
+func (*TlsRouting) ProtoMessage()    {}
+func (*TlsRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{8}
+}
+func (m *TlsRouting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TlsRouting.Unmarshal(m, b)
+}
+func (m *TlsRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TlsRouting.Marshal(b, m, deterministic)
+}
+func (dst *TlsRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TlsRouting.Merge(dst, src)
+}
+func (m *TlsRouting) XXX_Size() int {
+	return xxx_messageInfo_TlsRouting.Size(m)
+}
+func (m *TlsRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_TlsRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TlsRouting proto.InternalMessageInfo
+
+func (m *TlsRouting) GetMatchers() []*TlsMatcher {
+	if m != nil {
+		return m.Matchers
+	}
+	return nil
+}
+
+// parameters for matching tls connections
+type TlsMatcher struct {
+	// SNI (server name indicator) values to match on, at least one is required.
+	// wildcard prefixes can be used, e.g. *.com will match foo.example.com as well as example.com
+	SniHosts []string `protobuf:"bytes,1,rep,name=sni_hosts,json=sniHosts" json:"sni_hosts,omitempty"`
+	// IPv4 or IPv6 ip addresses of the destination with optional subnet, e.g. a.b.c.d/xx or just a.b.c.d
+	DestinationSubnets []string `protobuf:"bytes,2,rep,name=destination_subnets,json=destinationSubnets" json:"destination_subnets,omitempty"`
+	// the port on the destination being addressed. if empty, applies to every port
+	Port                 uint32   `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TlsMatcher) Reset()         { *m = TlsMatcher{} }
+func (m *TlsMatcher) String() string { return proto.CompactTextString(m) }
+func (*TlsMatcher) ProtoMessage()    {}
+func (*TlsMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{9}
+}
+func (m *TlsMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TlsMatcher.Unmarshal(m, b)
+}
+func (m *TlsMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TlsMatcher.Marshal(b, m, deterministic)
+}
+func (dst *TlsMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TlsMatcher.Merge(dst, src)
+}
+func (m *TlsMatcher) XXX_Size() int {
+	return xxx_messageInfo_TlsMatcher.Size(m)
+}
+func (m *TlsMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_TlsMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TlsMatcher proto.InternalMessageInfo
+
+func (m *TlsMatcher) GetSniHosts() []string {
+	if m != nil {
+		return m.SniHosts
+	}
+	return nil
+}
+
+func (m *TlsMatcher) GetDestinationSubnets() []string {
+	if m != nil {
+		return m.DestinationSubnets
+	}
+	return nil
+}
+
+func (m *TlsMatcher) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
 // manipulate request and response headers
 type HeaderManipulation struct {
 	// HTTP headers to remove before returning a response to the caller.
@@ -292,7 +710,7 @@ func (m *HeaderManipulation) Reset()         { *m = HeaderManipulation{} }
 func (m *HeaderManipulation) String() string { return proto.CompactTextString(m) }
 func (*HeaderManipulation) ProtoMessage()    {}
 func (*HeaderManipulation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_routing_4812d946645e7c0f, []int{3}
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{10}
 }
 func (m *HeaderManipulation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeaderManipulation.Unmarshal(m, b)
@@ -352,7 +770,7 @@ func (m *Percent) Reset()         { *m = Percent{} }
 func (m *Percent) String() string { return proto.CompactTextString(m) }
 func (*Percent) ProtoMessage()    {}
 func (*Percent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_routing_4812d946645e7c0f, []int{4}
+	return fileDescriptor_routing_7b738b04c87ac84d, []int{11}
 }
 func (m *Percent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Percent.Unmarshal(m, b)
@@ -381,8 +799,16 @@ func (m *Percent) GetValue() float64 {
 
 func init() {
 	proto.RegisterType((*RoutingRule)(nil), "supergloo.solo.io.RoutingRule")
+	proto.RegisterType((*UpstreamSelector)(nil), "supergloo.solo.io.UpstreamSelector")
+	proto.RegisterMapType((map[string]string)(nil), "supergloo.solo.io.UpstreamSelector.LabelsEntry")
 	proto.RegisterType((*TrafficShifting)(nil), "supergloo.solo.io.TrafficShifting")
 	proto.RegisterType((*WeightedDestination)(nil), "supergloo.solo.io.WeightedDestination")
+	proto.RegisterType((*Mirror)(nil), "supergloo.solo.io.Mirror")
+	proto.RegisterType((*TrafficPolicy)(nil), "supergloo.solo.io.TrafficPolicy")
+	proto.RegisterType((*TcpRouting)(nil), "supergloo.solo.io.TcpRouting")
+	proto.RegisterType((*TcpMatcher)(nil), "supergloo.solo.io.TcpMatcher")
+	proto.RegisterType((*TlsRouting)(nil), "supergloo.solo.io.TlsRouting")
+	proto.RegisterType((*TlsMatcher)(nil), "supergloo.solo.io.TlsMatcher")
 	proto.RegisterType((*HeaderManipulation)(nil), "supergloo.solo.io.HeaderManipulation")
 	proto.RegisterMapType((map[string]string)(nil), "supergloo.solo.io.HeaderManipulation.AppendRequestHeadersEntry")
 	proto.RegisterMapType((map[string]string)(nil), "supergloo.solo.io.HeaderManipulation.AppendResponseHeadersEntry")
@@ -424,6 +850,9 @@ func (this *RoutingRule) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.SourceSelector.Equal(that1.SourceSelector) {
+		return false
+	}
 	if len(this.Destinations) != len(that1.Destinations) {
 		return false
 	}
@@ -432,6 +861,9 @@ func (this *RoutingRule) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.DestinationSelector.Equal(that1.DestinationSelector) {
+		return false
+	}
 	if len(this.RequestMatchers) != len(that1.RequestMatchers) {
 		return false
 	}
@@ -461,6 +893,66 @@ func (this *RoutingRule) Equal(that interface{}) bool {
 	if !this.HeaderManipulaition.Equal(that1.HeaderManipulaition) {
 		return false
 	}
+	if !this.TcpRouting.Equal(that1.TcpRouting) {
+		return false
+	}
+	if !this.TlsRouting.Equal(that1.TlsRouting) {
+		return false
+	}
+	if !this.TrafficPolicy.Equal(that1.TrafficPolicy) {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UpstreamSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpstreamSelector)
+	if !ok {
+		that2, ok := that.(UpstreamSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Services) != len(that1.Services) {
+		return false
+	}
+	for i := range this.Services {
+		if !this.Services[i].Equal(that1.Services[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -528,6 +1020,211 @@ func (this *WeightedDestination) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Mirror) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Mirror)
+	if !ok {
+		that2, ok := that.(Mirror)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Upstream.Equal(that1.Upstream) {
+		return false
+	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TrafficPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TrafficPolicy)
+	if !ok {
+		that2, ok := that.(TrafficPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LoadBalancer.Equal(that1.LoadBalancer) {
+		return false
+	}
+	if !this.ConnectionPool.Equal(that1.ConnectionPool) {
+		return false
+	}
+	if !this.OutlierDetection.Equal(that1.OutlierDetection) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TcpRouting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TcpRouting)
+	if !ok {
+		that2, ok := that.(TcpRouting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Matchers) != len(that1.Matchers) {
+		return false
+	}
+	for i := range this.Matchers {
+		if !this.Matchers[i].Equal(that1.Matchers[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TcpMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TcpMatcher)
+	if !ok {
+		that2, ok := that.(TcpMatcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DestinationSubnets) != len(that1.DestinationSubnets) {
+		return false
+	}
+	for i := range this.DestinationSubnets {
+		if this.DestinationSubnets[i] != that1.DestinationSubnets[i] {
+			return false
+		}
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TlsRouting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TlsRouting)
+	if !ok {
+		that2, ok := that.(TlsRouting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Matchers) != len(that1.Matchers) {
+		return false
+	}
+	for i := range this.Matchers {
+		if !this.Matchers[i].Equal(that1.Matchers[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *TlsMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TlsMatcher)
+	if !ok {
+		that2, ok := that.(TlsMatcher)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SniHosts) != len(that1.SniHosts) {
+		return false
+	}
+	for i := range this.SniHosts {
+		if this.SniHosts[i] != that1.SniHosts[i] {
+			return false
+		}
+	}
+	if len(this.DestinationSubnets) != len(that1.DestinationSubnets) {
+		return false
+	}
+	for i := range this.DestinationSubnets {
+		if this.DestinationSubnets[i] != that1.DestinationSubnets[i] {
+			return false
+		}
+	}
+	if this.Port != that1.Port {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *HeaderManipulation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -612,61 +1309,86 @@ func (this *Percent) Equal(that interface{}) bool {
 	return true
 }
 
-func init() { proto.RegisterFile("routing.proto", fileDescriptor_routing_4812d946645e7c0f) }
-
-var fileDescriptor_routing_4812d946645e7c0f = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xed, 0x4e, 0xe4, 0x36,
-	0x14, 0xed, 0x00, 0x3b, 0x80, 0x81, 0x1d, 0xd6, 0x0c, 0x6c, 0x98, 0x1f, 0x80, 0x46, 0x6a, 0x97,
-	0x1f, 0xdd, 0x44, 0x94, 0xb6, 0x42, 0x48, 0x55, 0x29, 0xdd, 0x76, 0x69, 0xa5, 0x91, 0x90, 0x17,
-	0xa9, 0x55, 0xa5, 0x2a, 0x32, 0xc9, 0x4d, 0xe2, 0x92, 0xc4, 0xa9, 0x3f, 0x86, 0xe5, 0x05, 0xfa,
-	0x2c, 0x7d, 0x94, 0x3e, 0xc5, 0x4a, 0xed, 0x23, 0xf4, 0x09, 0xaa, 0xd8, 0xce, 0x40, 0x60, 0xa6,
-	0xcb, 0xfe, 0x1a, 0xdb, 0xf7, 0x9c, 0x73, 0x8f, 0xef, 0xbd, 0x9e, 0xa0, 0x35, 0xc1, 0xb5, 0x62,
-	0x65, 0xea, 0x57, 0x82, 0x2b, 0x8e, 0x9f, 0x49, 0x5d, 0x81, 0x48, 0x73, 0xce, 0x7d, 0xc9, 0x73,
-	0xee, 0x33, 0x3e, 0xe8, 0xa7, 0x3c, 0xe5, 0x26, 0x1a, 0xd4, 0x2b, 0x0b, 0x1c, 0xec, 0xa4, 0x9c,
-	0xa7, 0x39, 0x04, 0x66, 0x77, 0xa9, 0x93, 0x20, 0xd6, 0x82, 0x2a, 0xc6, 0xcb, 0x59, 0xf1, 0x6b,
-	0x41, 0xab, 0x0a, 0x84, 0x74, 0xf1, 0x8d, 0x3a, 0x47, 0x30, 0x3e, 0xa8, 0x01, 0x6f, 0x6f, 0xdc,
-	0x61, 0xc0, 0xa4, 0x62, 0x3c, 0x28, 0x41, 0x5d, 0x73, 0x71, 0xc5, 0xca, 0x34, 0x18, 0x1f, 0xd0,
-	0xbc, 0xca, 0xe8, 0x61, 0x30, 0x66, 0x42, 0x69, 0x9a, 0x87, 0x12, 0xc4, 0x98, 0x45, 0xe0, 0x08,
-	0x07, 0x29, 0x53, 0x99, 0xbe, 0xf4, 0x23, 0x5e, 0x04, 0xb5, 0xdf, 0x97, 0x8c, 0xdb, 0xdf, 0x2b,
-	0xa6, 0x02, 0x5a, 0xb1, 0x3a, 0x41, 0x01, 0x8a, 0xc6, 0x54, 0xd1, 0x26, 0xc7, 0x23, 0x28, 0x52,
-	0x51, 0xa5, 0x1b, 0xa7, 0x9f, 0x3e, 0x82, 0x20, 0x20, 0xb1, 0xe8, 0xe1, 0xdf, 0x5d, 0xb4, 0x42,
-	0x6c, 0x49, 0x89, 0xce, 0x01, 0xbf, 0x46, 0x5d, 0xab, 0xe6, 0xc5, 0x7b, 0x9d, 0xfd, 0x95, 0xcf,
-	0xfa, 0x7e, 0xc4, 0x05, 0x34, 0xc5, 0xf5, 0xdf, 0x98, 0xd8, 0xe9, 0xf6, 0x5f, 0xef, 0x76, 0x3f,
-	0xfa, 0xf7, 0xdd, 0xee, 0x33, 0x05, 0x52, 0xc5, 0x2c, 0x49, 0x8e, 0x87, 0x2c, 0x2d, 0xb9, 0x80,
-	0x21, 0x71, 0x74, 0x7c, 0x84, 0x96, 0x9a, 0x9b, 0x78, 0x91, 0x91, 0xda, 0x6a, 0x4b, 0x8d, 0x5c,
-	0xf4, 0x74, 0xa1, 0x16, 0x23, 0x13, 0x34, 0x3e, 0x46, 0x2b, 0x8a, 0x8a, 0x14, 0x54, 0x58, 0x80,
-	0xcc, 0xbc, 0x8e, 0x21, 0x6f, 0xb7, 0xc9, 0x04, 0x24, 0xd7, 0x22, 0x02, 0x02, 0x09, 0x41, 0x16,
-	0x3d, 0x02, 0x99, 0xe1, 0x43, 0xb4, 0x68, 0x03, 0xd2, 0x9b, 0xdb, 0x9b, 0xff, 0x7f, 0x5e, 0x83,
-	0xc4, 0x5f, 0xa1, 0xd5, 0x18, 0xa4, 0x62, 0xa5, 0x19, 0x08, 0xe9, 0xcd, 0xbf, 0x8f, 0xd9, 0x82,
-	0xe3, 0x13, 0xb4, 0x2e, 0xe0, 0x77, 0x0d, 0x52, 0x85, 0x05, 0x55, 0x51, 0x06, 0x42, 0x7a, 0x0b,
-	0x46, 0x62, 0xd3, 0xbf, 0x3b, 0x99, 0xfe, 0xc8, 0x46, 0x49, 0xcf, 0xc1, 0xdd, 0x5e, 0xe2, 0x11,
-	0x5a, 0x57, 0x82, 0x26, 0x09, 0x8b, 0x42, 0x99, 0xb1, 0xa4, 0x6e, 0x86, 0xf7, 0xc4, 0x5c, 0x7b,
-	0xe8, 0x3f, 0x18, 0x70, 0xff, 0xc2, 0x42, 0xdf, 0x38, 0x24, 0xe9, 0xa9, 0xf6, 0x01, 0x3e, 0x47,
-	0xbd, 0x84, 0xea, 0x5c, 0x85, 0xac, 0xfc, 0x0d, 0xa2, 0xda, 0xa4, 0xd7, 0x35, 0x6a, 0x2f, 0xfc,
-	0xdb, 0x51, 0xf5, 0xcd, 0xec, 0xd6, 0x7a, 0x67, 0x17, 0x17, 0xe7, 0xdf, 0xd7, 0xf8, 0x1f, 0x1a,
-	0x38, 0x79, 0x9a, 0xb4, 0xf6, 0x75, 0x59, 0x15, 0x2b, 0x80, 0x6b, 0xe5, 0x2d, 0xba, 0x76, 0xd8,
-	0xf7, 0xe2, 0x37, 0xef, 0xc5, 0x7f, 0xe5, 0xde, 0x13, 0x69, 0x90, 0xf8, 0x08, 0x2d, 0x0a, 0x50,
-	0x82, 0x81, 0xf4, 0x96, 0x0c, 0x69, 0x67, 0x66, 0x7a, 0x02, 0x4a, 0xdc, 0x90, 0x06, 0x8e, 0x4f,
-	0xd0, 0x4a, 0xc4, 0x85, 0x0c, 0x2b, 0x9e, 0xb3, 0xe8, 0xc6, 0x43, 0x86, 0xbd, 0x3b, 0x95, 0xfd,
-	0x2d, 0x17, 0xf2, 0xdc, 0xc0, 0x08, 0x8a, 0x26, 0x6b, 0x7c, 0x80, 0xba, 0x05, 0x13, 0x82, 0x0b,
-	0x6f, 0xb9, 0xf1, 0x7b, 0xb7, 0x84, 0xaf, 0x6e, 0xfb, 0x47, 0x1c, 0x10, 0xff, 0x8c, 0xfa, 0x19,
-	0xd0, 0x18, 0x44, 0x58, 0xd0, 0x92, 0x55, 0x3a, 0xa7, 0xcc, 0x94, 0x6e, 0xd5, 0x08, 0x7c, 0x3c,
-	0xa5, 0x11, 0x67, 0x06, 0x3e, 0x72, 0x68, 0x23, 0xb6, 0x91, 0xb5, 0xce, 0x8c, 0xc2, 0xf0, 0x57,
-	0xd4, 0xbb, 0xd7, 0x33, 0xfc, 0xe3, 0xbd, 0x91, 0xeb, 0x98, 0x79, 0xf9, 0x64, 0x4a, 0x92, 0x9f,
-	0x80, 0xa5, 0x99, 0x82, 0xf8, 0xae, 0xe5, 0x16, 0x77, 0x18, 0xa3, 0x8d, 0x29, 0x20, 0xfc, 0x05,
-	0x5a, 0xd2, 0x95, 0x54, 0x02, 0x68, 0xf1, 0xfe, 0x37, 0x34, 0x81, 0xe2, 0x2d, 0xd4, 0xbd, 0x36,
-	0x6a, 0xde, 0xdc, 0x5e, 0x67, 0x7f, 0x8d, 0xb8, 0xdd, 0xf0, 0x8f, 0x05, 0x84, 0x1f, 0x5e, 0x18,
-	0x7f, 0x89, 0x9e, 0x0b, 0x28, 0xf8, 0x18, 0x42, 0x01, 0xb2, 0xe2, 0xa5, 0x84, 0xd0, 0x96, 0x40,
-	0x7a, 0xab, 0x7b, 0xf3, 0xfb, 0xcb, 0x64, 0xd3, 0x86, 0x89, 0x8b, 0x5a, 0x09, 0x89, 0xdf, 0xa2,
-	0xe7, 0xf5, 0xff, 0x6b, 0x19, 0x3f, 0xe4, 0xad, 0x99, 0x5a, 0x9c, 0x3c, 0xaa, 0xe0, 0xfe, 0x37,
-	0x46, 0xe4, 0x9e, 0xfa, 0x77, 0x65, 0x3d, 0x4e, 0x9b, 0x74, 0x5a, 0x0c, 0x7f, 0x8e, 0xb6, 0x26,
-	0x8e, 0xed, 0xab, 0x6d, 0x12, 0x3f, 0x35, 0x86, 0xfb, 0x8d, 0x61, 0x13, 0x6c, 0x58, 0x1a, 0x6d,
-	0x4d, 0xfc, 0xb6, 0x59, 0x3d, 0x63, 0xf7, 0xeb, 0x0f, 0xb3, 0x7b, 0x57, 0xdb, 0xba, 0xed, 0xd3,
-	0x29, 0xa1, 0xc1, 0x19, 0x1a, 0xcc, 0xbe, 0x21, 0x5e, 0x47, 0xf3, 0x57, 0x70, 0x63, 0xba, 0xbb,
-	0x4c, 0xea, 0x25, 0xee, 0xa3, 0x27, 0x63, 0x9a, 0x6b, 0x30, 0xcd, 0x5b, 0x26, 0x76, 0x73, 0x3c,
-	0x77, 0xd4, 0x19, 0xbc, 0x46, 0xdb, 0x33, 0x93, 0x7f, 0x88, 0xd0, 0x70, 0x17, 0x2d, 0x9e, 0x83,
-	0x88, 0xa0, 0x54, 0xb7, 0xa0, 0x9a, 0xd8, 0x71, 0xa0, 0xd3, 0x97, 0x7f, 0xfe, 0xb3, 0xd3, 0xf9,
-	0xe5, 0xc5, 0xb4, 0xcf, 0x50, 0x53, 0xa2, 0xa0, 0xba, 0x4a, 0xdd, 0xb7, 0xe8, 0xb2, 0x6b, 0xfe,
-	0x42, 0x0e, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xdc, 0xff, 0xb2, 0x44, 0xda, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("routing.proto", fileDescriptor_routing_7b738b04c87ac84d) }
+
+var fileDescriptor_routing_7b738b04c87ac84d = []byte{
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdf, 0x72, 0x1b, 0xb5,
+	0x17, 0xfe, 0x39, 0x49, 0x13, 0xe7, 0x38, 0x8e, 0x1d, 0xc5, 0x49, 0xb7, 0xfe, 0x41, 0x92, 0x31,
+	0x53, 0x5a, 0x06, 0xba, 0x9e, 0xb6, 0xc0, 0xb4, 0x1d, 0xfe, 0x94, 0x50, 0x48, 0xda, 0x69, 0x20,
+	0x28, 0x69, 0x61, 0x98, 0x61, 0x76, 0x94, 0xf5, 0xb1, 0x2d, 0xb2, 0x5e, 0x2d, 0x92, 0x36, 0x6d,
+	0xae, 0x99, 0x61, 0x86, 0x37, 0xe1, 0x51, 0x78, 0x8a, 0x5e, 0x70, 0x0d, 0x37, 0x3c, 0x01, 0xb3,
+	0x92, 0x76, 0x63, 0x27, 0x36, 0x75, 0xb8, 0xb2, 0x74, 0xce, 0x77, 0x3e, 0x7d, 0x3a, 0x96, 0x3e,
+	0x2d, 0x54, 0xa5, 0x48, 0x35, 0x8f, 0x7b, 0x7e, 0x22, 0x85, 0x16, 0x64, 0x45, 0xa5, 0x09, 0xca,
+	0x5e, 0x24, 0x84, 0xaf, 0x44, 0x24, 0x7c, 0x2e, 0x9a, 0x8d, 0x9e, 0xe8, 0x09, 0x93, 0x6d, 0x67,
+	0x23, 0x0b, 0x6c, 0x6e, 0xf4, 0x84, 0xe8, 0x45, 0xd8, 0x36, 0xb3, 0xa3, 0xb4, 0xdb, 0xee, 0xa4,
+	0x92, 0x69, 0x2e, 0xe2, 0x49, 0xf9, 0x17, 0x92, 0x25, 0x09, 0x4a, 0xe5, 0xf2, 0xab, 0xd9, 0x1a,
+	0xed, 0x93, 0xdb, 0x19, 0xe0, 0xe5, 0xa9, 0x0b, 0xae, 0x9d, 0x70, 0xa9, 0x53, 0x16, 0x05, 0x0a,
+	0xe5, 0x09, 0x0f, 0xd1, 0x85, 0xd7, 0x3b, 0xa8, 0x34, 0x8f, 0x0d, 0x7d, 0x20, 0xd3, 0x28, 0x8f,
+	0xdf, 0xee, 0x71, 0xdd, 0x4f, 0x8f, 0xfc, 0x50, 0x0c, 0xda, 0x99, 0xda, 0x5b, 0x5c, 0xd8, 0xdf,
+	0x63, 0xae, 0xdb, 0x2c, 0xe1, 0x19, 0xfd, 0x00, 0x35, 0xeb, 0x30, 0xcd, 0x5c, 0x49, 0x7b, 0x8a,
+	0x12, 0xa5, 0x99, 0x4e, 0x73, 0x9d, 0xef, 0x4d, 0x51, 0x20, 0xb1, 0x6b, 0xd1, 0xad, 0x3f, 0x17,
+	0xa1, 0x42, 0x6d, 0x43, 0x69, 0x1a, 0x21, 0xd9, 0x81, 0x79, 0xcb, 0xe6, 0x75, 0xb6, 0x4a, 0x37,
+	0x2b, 0x77, 0x1a, 0x7e, 0x28, 0x24, 0xe6, 0xad, 0xf5, 0x0f, 0x4c, 0x6e, 0xfb, 0xda, 0xef, 0xaf,
+	0x36, 0xff, 0xf7, 0xf7, 0xab, 0xcd, 0x15, 0x8d, 0x4a, 0x77, 0x78, 0xb7, 0xfb, 0xa0, 0xc5, 0x7b,
+	0xb1, 0x90, 0xd8, 0xa2, 0xae, 0x9c, 0xdc, 0x83, 0x72, 0xbe, 0x13, 0x2f, 0x34, 0x54, 0xeb, 0xa3,
+	0x54, 0x7b, 0x2e, 0xbb, 0x3d, 0x97, 0x91, 0xd1, 0x02, 0x4d, 0x1e, 0x40, 0x45, 0x33, 0xd9, 0x43,
+	0x1d, 0x0c, 0x50, 0xf5, 0xbd, 0x92, 0x29, 0xbe, 0x36, 0x5a, 0x4c, 0x51, 0x89, 0x54, 0x86, 0x48,
+	0xb1, 0x4b, 0xc1, 0xa2, 0xf7, 0x50, 0xf5, 0xc9, 0x5d, 0x58, 0xb0, 0x09, 0xe5, 0xcd, 0x6c, 0xcd,
+	0xfe, 0x7b, 0x5d, 0x8e, 0x24, 0x4f, 0xa1, 0x66, 0x87, 0x81, 0xc2, 0x08, 0x43, 0x2d, 0xa4, 0xb7,
+	0x62, 0x16, 0x7d, 0xcb, 0xbf, 0x70, 0xb8, 0xfc, 0x67, 0x89, 0xd2, 0x12, 0xd9, 0xe0, 0xc0, 0x41,
+	0xe9, 0xb2, 0xad, 0xcd, 0xe7, 0xe4, 0x63, 0x58, 0x1a, 0xfa, 0xf7, 0x95, 0x37, 0xfb, 0x3a, 0x1d,
+	0x23, 0x70, 0xf2, 0x1c, 0x1a, 0xc3, 0x87, 0xa7, 0x50, 0x44, 0xa6, 0x57, 0xb4, 0x3a, 0x44, 0x50,
+	0xc8, 0x7a, 0x08, 0x75, 0x89, 0x3f, 0xa5, 0xa8, 0x74, 0x30, 0x60, 0x3a, 0xec, 0xa3, 0x54, 0xde,
+	0x9c, 0x91, 0xb6, 0xe6, 0x8f, 0xd0, 0xed, 0xd9, 0x2c, 0xad, 0x39, 0xb8, 0x9b, 0x2b, 0xb2, 0x07,
+	0x75, 0x2d, 0x59, 0xb7, 0xcb, 0xc3, 0x40, 0xf5, 0x79, 0x37, 0x3b, 0x32, 0xde, 0x15, 0xa3, 0xaa,
+	0x35, 0x46, 0xd5, 0xa1, 0x85, 0x1e, 0x38, 0x24, 0xad, 0xe9, 0xd1, 0x00, 0xd9, 0x87, 0x5a, 0x97,
+	0xa5, 0x91, 0x0e, 0x78, 0xfc, 0x23, 0x86, 0x99, 0x56, 0x6f, 0xde, 0xb0, 0xdd, 0xf0, 0x63, 0xd4,
+	0x2f, 0x84, 0x3c, 0xce, 0x2e, 0x39, 0x57, 0x9a, 0x1b, 0xbe, 0xdd, 0xc3, 0xc3, 0xfd, 0x2f, 0x33,
+	0xfc, 0xe3, 0x1c, 0x4e, 0x97, 0xbb, 0x23, 0xf3, 0xec, 0xcf, 0xd7, 0x7c, 0x80, 0x22, 0xd5, 0xde,
+	0x82, 0x3b, 0x34, 0xf6, 0x4e, 0xfb, 0xf9, 0x9d, 0xf6, 0x1f, 0xb9, 0x3b, 0x4f, 0x73, 0x24, 0xb9,
+	0x07, 0x0b, 0x12, 0xb5, 0xe4, 0xa8, 0xbc, 0xb2, 0x29, 0xda, 0x98, 0xb8, 0x3c, 0x45, 0x2d, 0x4f,
+	0x69, 0x0e, 0x27, 0x0f, 0xa1, 0x12, 0x0a, 0xa9, 0x82, 0x44, 0x44, 0x3c, 0x3c, 0xf5, 0xc0, 0x54,
+	0x6f, 0x8e, 0xad, 0xfe, 0x5c, 0x48, 0xb5, 0x6f, 0x60, 0x14, 0xc2, 0x62, 0x4c, 0x6e, 0xc3, 0xfc,
+	0x80, 0x4b, 0x29, 0xa4, 0xb7, 0xe8, 0xf4, 0x5e, 0xec, 0xe3, 0x9e, 0x01, 0x50, 0x07, 0x24, 0xdf,
+	0x41, 0xa3, 0x8f, 0xac, 0x83, 0x32, 0x18, 0xb0, 0x98, 0x27, 0x69, 0xc4, 0xb8, 0x69, 0xdd, 0x92,
+	0x21, 0xb8, 0x3e, 0x86, 0x60, 0xd7, 0xc0, 0xf7, 0x1c, 0xda, 0x6c, 0x7e, 0xb5, 0x3f, 0x12, 0x33,
+	0x0c, 0xe4, 0x13, 0xa8, 0xe8, 0x30, 0x09, 0x9c, 0xbb, 0x7a, 0x55, 0x43, 0xf8, 0xe6, 0xb8, 0x7f,
+	0x36, 0x4c, 0x72, 0xc7, 0x00, 0x5d, 0x8c, 0x4d, 0x7d, 0xa4, 0x8a, 0xfa, 0xe5, 0xc9, 0xf5, 0x91,
+	0x3a, 0xab, 0x2f, 0xc6, 0x64, 0x07, 0x96, 0xf3, 0xe3, 0xe5, 0x3a, 0x5a, 0x33, 0x14, 0x5b, 0x93,
+	0x0f, 0x97, 0x6b, 0x69, 0x55, 0x0f, 0x4f, 0x49, 0x13, 0xca, 0x89, 0xe4, 0x42, 0x72, 0x7d, 0xea,
+	0xd5, 0xb7, 0x4a, 0x37, 0xab, 0xb4, 0x98, 0xb7, 0xfe, 0x2a, 0x41, 0xfd, 0xfc, 0x7d, 0xc9, 0x3c,
+	0x2f, 0x62, 0x47, 0x18, 0x29, 0xaf, 0x64, 0x2e, 0x44, 0x7b, 0x8a, 0x4b, 0xe6, 0x3f, 0x35, 0x15,
+	0x5f, 0xc4, 0xd9, 0x91, 0x70, 0xe5, 0x64, 0x03, 0x20, 0x66, 0x03, 0x54, 0x09, 0xcb, 0x0d, 0x68,
+	0x91, 0x0e, 0x45, 0xc8, 0x07, 0x50, 0x76, 0xef, 0xc4, 0x14, 0xb6, 0x50, 0x40, 0x9b, 0xf7, 0xa1,
+	0x32, 0xb4, 0x1a, 0xa9, 0xc3, 0xec, 0x31, 0x9e, 0x1a, 0x5f, 0x5c, 0xa4, 0xd9, 0x90, 0x34, 0xe0,
+	0xca, 0x09, 0x8b, 0x52, 0xf4, 0x66, 0x4c, 0xcc, 0x4e, 0x1e, 0xcc, 0xdc, 0x2b, 0xb5, 0x7e, 0x80,
+	0xda, 0xb9, 0x8b, 0x48, 0x9e, 0x9c, 0xf3, 0x27, 0xbb, 0xe7, 0xb7, 0xc7, 0xec, 0xf9, 0x5b, 0xe4,
+	0xbd, 0xbe, 0xc6, 0xce, 0xa3, 0x33, 0xf8, 0xa8, 0x59, 0xb5, 0x3a, 0xb0, 0x3a, 0x06, 0x94, 0xed,
+	0x33, 0x75, 0xfd, 0x7a, 0xbd, 0x7d, 0x17, 0x50, 0xb2, 0x0e, 0xf3, 0x2f, 0x0c, 0x9b, 0xd9, 0x47,
+	0x95, 0xba, 0x59, 0xeb, 0xe7, 0x12, 0xcc, 0xdb, 0x6b, 0xf0, 0x5f, 0x99, 0x3f, 0x02, 0x48, 0x50,
+	0x86, 0x18, 0x6b, 0xd6, 0x43, 0x6f, 0xd6, 0x14, 0xbe, 0x71, 0xc1, 0x1c, 0x9e, 0x3d, 0x8e, 0xf5,
+	0xdd, 0x3b, 0xcf, 0xb3, 0xe6, 0xd1, 0x21, 0xfc, 0x93, 0xb9, 0xf2, 0x4c, 0x7d, 0xb6, 0xf5, 0xeb,
+	0x0c, 0x54, 0x47, 0xce, 0x1d, 0xf9, 0x0a, 0xaa, 0x91, 0x60, 0x9d, 0xe0, 0x88, 0x45, 0x2c, 0x0e,
+	0x51, 0x3a, 0x45, 0xef, 0x8c, 0xb5, 0x80, 0xa7, 0x82, 0x75, 0xb6, 0x1d, 0xf0, 0x00, 0x75, 0xf6,
+	0x57, 0x28, 0xba, 0x14, 0x0d, 0x45, 0xc9, 0x21, 0xd4, 0x42, 0x11, 0xc7, 0xd6, 0xcd, 0x82, 0x44,
+	0x88, 0xc8, 0x34, 0xa2, 0x72, 0xe7, 0xdd, 0x09, 0xa6, 0x92, 0x63, 0xf7, 0x85, 0x88, 0x0a, 0xce,
+	0xe5, 0x70, 0x24, 0x4e, 0x28, 0xac, 0x88, 0x54, 0x47, 0x1c, 0x65, 0xd0, 0x41, 0x6d, 0x13, 0xae,
+	0x05, 0xd7, 0xc7, 0xf2, 0x7e, 0x6d, 0xd1, 0x8f, 0x72, 0x30, 0xad, 0x8b, 0x73, 0x91, 0xd6, 0x0e,
+	0xc0, 0x99, 0x0b, 0x90, 0xfb, 0x50, 0x2e, 0x9e, 0x14, 0x7b, 0x9a, 0x26, 0xd8, 0x46, 0xfe, 0xb4,
+	0x14, 0xf0, 0xd6, 0x37, 0x00, 0x67, 0x71, 0xd2, 0x86, 0xd5, 0x91, 0xb7, 0x2f, 0x3d, 0x8a, 0x51,
+	0x5b, 0xce, 0x45, 0x4a, 0x86, 0x5f, 0x35, 0x9b, 0x21, 0x04, 0xe6, 0x12, 0x21, 0xf3, 0xf3, 0x62,
+	0xc6, 0x46, 0x5b, 0xa4, 0x2e, 0xa9, 0x2d, 0x52, 0x17, 0xb5, 0xc5, 0x00, 0x67, 0x71, 0xf2, 0x7f,
+	0x58, 0x54, 0x31, 0x0f, 0xfa, 0x42, 0x15, 0x8a, 0xca, 0x2a, 0xe6, 0xbb, 0xd9, 0x7c, 0x92, 0xf0,
+	0x99, 0xd7, 0x0a, 0x9f, 0x1d, 0x12, 0xfe, 0xcb, 0x1c, 0x90, 0x8b, 0x66, 0x4d, 0x3e, 0x84, 0xab,
+	0x12, 0x07, 0xe2, 0x04, 0x03, 0x89, 0x2a, 0x11, 0xb1, 0xc2, 0xc0, 0xda, 0xb7, 0xf2, 0x96, 0x0c,
+	0xff, 0x9a, 0x4d, 0x53, 0x97, 0xb5, 0x14, 0x8a, 0xbc, 0x84, 0xab, 0xd9, 0xf7, 0x6b, 0xdc, 0xb9,
+	0x58, 0x57, 0x35, 0x8d, 0x78, 0x38, 0xd5, 0x63, 0xe1, 0x7f, 0x66, 0x48, 0xce, 0xb1, 0x5b, 0xdf,
+	0x5b, 0x63, 0xe3, 0x72, 0xe4, 0x7d, 0x58, 0x2f, 0x14, 0xdb, 0x2f, 0x8e, 0x7c, 0xe1, 0x65, 0x23,
+	0xb8, 0x91, 0x0b, 0x36, 0xc9, 0xbc, 0x2a, 0x85, 0xf5, 0x42, 0xef, 0x68, 0x55, 0xcd, 0xc8, 0xfd,
+	0xf4, 0x72, 0x72, 0x87, 0xb9, 0xad, 0xda, 0x06, 0x1b, 0x93, 0x6a, 0xee, 0x42, 0x73, 0xf2, 0x0e,
+	0x2f, 0xe3, 0xb5, 0xcd, 0x1d, 0xb8, 0x36, 0x71, 0xf1, 0x4b, 0x99, 0xf6, 0x26, 0x2c, 0xec, 0x5b,
+	0xf7, 0x39, 0x03, 0x65, 0x85, 0x25, 0x07, 0xda, 0xbe, 0xf5, 0xdb, 0x1f, 0x1b, 0xa5, 0xef, 0x6f,
+	0x8c, 0xfb, 0xd0, 0xcf, 0x5b, 0xd4, 0x4e, 0x8e, 0x7b, 0xee, 0x6b, 0xff, 0x68, 0xde, 0x38, 0xdc,
+	0xdd, 0x7f, 0x06, 0x00, 0xd4, 0x59, 0xc6, 0x80, 0x3a, 0x0d, 0x00, 0x00,
 }
@@ -14,6 +14,7 @@ import (
 
 type TranslatorSnapshot struct {
 	Meshes       MeshesByNamespace
+	Meshpolicies MeshpoliciesByNamespace
 	Routingrules RoutingrulesByNamespace
 	Upstreams    gloo_solo_io.UpstreamsByNamespace
 	Istiocerts   encryption_istio_io.IstiocertsByNamespace
@@ -22,6 +23,7 @@ type TranslatorSnapshot struct {
 func (s TranslatorSnapshot) Clone() TranslatorSnapshot {
 	return TranslatorSnapshot{
 		Meshes:       s.Meshes.Clone(),
+		Meshpolicies: s.Meshpolicies.Clone(),
 		Routingrules: s.Routingrules.Clone(),
 		Upstreams:    s.Upstreams.Clone(),
 		Istiocerts:   s.Istiocerts.Clone(),
@@ -36,6 +38,12 @@ func (s TranslatorSnapshot) snapshotToHash() TranslatorSnapshot {
 		})
 		mesh.SetStatus(core.Status{})
 	}
+	for _, meshPolicy := range snapshotForHashing.Meshpolicies.List() {
+		resources.UpdateMetadata(meshPolicy, func(meta *core.Metadata) {
+			meta.ResourceVersion = ""
+		})
+		meshPolicy.SetStatus(core.Status{})
+	}
 	for _, routingRule := range snapshotForHashing.Routingrules.List() {
 		resources.UpdateMetadata(routingRule, func(meta *core.Metadata) {
 			meta.ResourceVersion = ""
@@ -66,6 +74,8 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	meshes := s.hashStruct(snapshotForHashing.Meshes.List())
 	fields = append(fields, zap.Uint64("meshes", meshes))
+	meshpolicies := s.hashStruct(snapshotForHashing.Meshpolicies.List())
+	fields = append(fields, zap.Uint64("meshpolicies", meshpolicies))
 	routingrules := s.hashStruct(snapshotForHashing.Routingrules.List())
 	fields = append(fields, zap.Uint64("routingrules", routingrules))
 	upstreams := s.hashStruct(snapshotForHashing.Upstreams.List())
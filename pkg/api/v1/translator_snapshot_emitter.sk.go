@@ -45,19 +45,21 @@ func init() {
 type TranslatorEmitter interface {
 	Register() error
 	Mesh() MeshClient
+	MeshPolicy() MeshPolicyClient
 	RoutingRule() RoutingRuleClient
 	Upstream() gloo_solo_io.UpstreamClient
 	IstioCacertsSecret() encryption_istio_io.IstioCacertsSecretClient
 	Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error)
 }
 
-func NewTranslatorEmitter(meshClient MeshClient, routingRuleClient RoutingRuleClient, upstreamClient gloo_solo_io.UpstreamClient, istioCacertsSecretClient encryption_istio_io.IstioCacertsSecretClient) TranslatorEmitter {
-	return NewTranslatorEmitterWithEmit(meshClient, routingRuleClient, upstreamClient, istioCacertsSecretClient, make(chan struct{}))
+func NewTranslatorEmitter(meshClient MeshClient, meshPolicyClient MeshPolicyClient, routingRuleClient RoutingRuleClient, upstreamClient gloo_solo_io.UpstreamClient, istioCacertsSecretClient encryption_istio_io.IstioCacertsSecretClient) TranslatorEmitter {
+	return NewTranslatorEmitterWithEmit(meshClient, meshPolicyClient, routingRuleClient, upstreamClient, istioCacertsSecretClient, make(chan struct{}))
 }
 
-func NewTranslatorEmitterWithEmit(meshClient MeshClient, routingRuleClient RoutingRuleClient, upstreamClient gloo_solo_io.UpstreamClient, istioCacertsSecretClient encryption_istio_io.IstioCacertsSecretClient, emit <-chan struct{}) TranslatorEmitter {
+func NewTranslatorEmitterWithEmit(meshClient MeshClient, meshPolicyClient MeshPolicyClient, routingRuleClient RoutingRuleClient, upstreamClient gloo_solo_io.UpstreamClient, istioCacertsSecretClient encryption_istio_io.IstioCacertsSecretClient, emit <-chan struct{}) TranslatorEmitter {
 	return &translatorEmitter{
 		mesh:               meshClient,
+		meshPolicy:         meshPolicyClient,
 		routingRule:        routingRuleClient,
 		upstream:           upstreamClient,
 		istioCacertsSecret: istioCacertsSecretClient,
@@ -68,6 +70,7 @@ func NewTranslatorEmitterWithEmit(meshClient MeshClient, routingRuleClient Routi
 type translatorEmitter struct {
 	forceEmit          <-chan struct{}
 	mesh               MeshClient
+	meshPolicy         MeshPolicyClient
 	routingRule        RoutingRuleClient
 	upstream           gloo_solo_io.UpstreamClient
 	istioCacertsSecret encryption_istio_io.IstioCacertsSecretClient
@@ -77,6 +80,9 @@ func (c *translatorEmitter) Register() error {
 	if err := c.mesh.Register(); err != nil {
 		return err
 	}
+	if err := c.meshPolicy.Register(); err != nil {
+		return err
+	}
 	if err := c.routingRule.Register(); err != nil {
 		return err
 	}
@@ -93,6 +99,10 @@ func (c *translatorEmitter) Mesh() MeshClient {
 	return c.mesh
 }
 
+func (c *translatorEmitter) MeshPolicy() MeshPolicyClient {
+	return c.meshPolicy
+}
+
 func (c *translatorEmitter) RoutingRule() RoutingRuleClient {
 	return c.routingRule
 }
@@ -115,6 +125,12 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 		namespace string
 	}
 	meshChan := make(chan meshListWithNamespace)
+	/* Create channel for MeshPolicy */
+	type meshPolicyListWithNamespace struct {
+		list      MeshPolicyList
+		namespace string
+	}
+	meshPolicyChan := make(chan meshPolicyListWithNamespace)
 	/* Create channel for RoutingRule */
 	type routingRuleListWithNamespace struct {
 		list      RoutingRuleList
@@ -146,6 +162,17 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, meshErrs, namespace+"-meshes")
 		}(namespace)
+		/* Setup watch for MeshPolicy */
+		meshPolicyNamespacesChan, meshPolicyErrs, err := c.meshPolicy.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting MeshPolicy watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, meshPolicyErrs, namespace+"-meshpolicies")
+		}(namespace)
 		/* Setup watch for RoutingRule */
 		routingRuleNamespacesChan, routingRuleErrs, err := c.routingRule.Watch(namespace, opts)
 		if err != nil {
@@ -192,6 +219,12 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 						return
 					case meshChan <- meshListWithNamespace{list: meshList, namespace: namespace}:
 					}
+				case meshPolicyList := <-meshPolicyNamespacesChan:
+					select {
+					case <-ctx.Done():
+						return
+					case meshPolicyChan <- meshPolicyListWithNamespace{list: meshPolicyList, namespace: namespace}:
+					}
 				case routingRuleList := <-routingRuleNamespacesChan:
 					select {
 					case <-ctx.Done():
@@ -239,6 +272,10 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 		      currentSnapshot.Meshes.Clear(meshNamespacedList.namespace)
 		      meshList := meshNamespacedList.list
 		   	currentSnapshot.Meshes.Add(meshList...)
+		      meshPolicyNamespacedList := <- meshPolicyChan
+		      currentSnapshot.Meshpolicies.Clear(meshPolicyNamespacedList.namespace)
+		      meshPolicyList := meshPolicyNamespacedList.list
+		   	currentSnapshot.Meshpolicies.Add(meshPolicyList...)
 		      routingRuleNamespacedList := <- routingRuleChan
 		      currentSnapshot.Routingrules.Clear(routingRuleNamespacedList.namespace)
 		      routingRuleList := routingRuleNamespacedList.list
@@ -276,6 +313,14 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 
 				currentSnapshot.Meshes.Clear(namespace)
 				currentSnapshot.Meshes.Add(meshList...)
+			case meshPolicyNamespacedList := <-meshPolicyChan:
+				record()
+
+				namespace := meshPolicyNamespacedList.namespace
+				meshPolicyList := meshPolicyNamespacedList.list
+
+				currentSnapshot.Meshpolicies.Clear(namespace)
+				currentSnapshot.Meshpolicies.Add(meshPolicyList...)
 			case routingRuleNamespacedList := <-routingRuleChan:
 				record()
 
@@ -35,6 +35,7 @@ var _ = Describe("V1Emitter", func() {
 		cfg                      *rest.Config
 		emitter                  TranslatorEmitter
 		meshClient               MeshClient
+		meshPolicyClient         MeshPolicyClient
 		routingRuleClient        RoutingRuleClient
 		upstreamClient           gloo_solo_io.UpstreamClient
 		istioCacertsSecretClient encryption_istio_io.IstioCacertsSecretClient
@@ -61,6 +62,15 @@ var _ = Describe("V1Emitter", func() {
 		meshClient, err = NewMeshClient(meshClientFactory)
 		Expect(err).NotTo(HaveOccurred())
 
+		// MeshPolicy Constructor
+		meshPolicyClientFactory := &factory.KubeResourceClientFactory{
+			Crd:         MeshPolicyCrd,
+			Cfg:         cfg,
+			SharedCache: cache,
+		}
+		meshPolicyClient, err = NewMeshPolicyClient(meshPolicyClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+
 		// RoutingRule Constructor
 		routingRuleClientFactory := &factory.KubeResourceClientFactory{
 			Crd:         RoutingRuleCrd,
@@ -88,7 +98,7 @@ var _ = Describe("V1Emitter", func() {
 		}
 		istioCacertsSecretClient, err = encryption_istio_io.NewIstioCacertsSecretClient(istioCacertsSecretClientFactory)
 		Expect(err).NotTo(HaveOccurred())
-		emitter = NewTranslatorEmitter(meshClient, routingRuleClient, upstreamClient, istioCacertsSecretClient)
+		emitter = NewTranslatorEmitter(meshClient, meshPolicyClient, routingRuleClient, upstreamClient, istioCacertsSecretClient)
 	})
 	AfterEach(func() {
 		setup.TeardownKube(namespace1)
@@ -167,6 +177,66 @@ var _ = Describe("V1Emitter", func() {
 
 		assertSnapshotMeshes(nil, MeshList{mesh1a, mesh1b, mesh2a, mesh2b})
 
+		/*
+			MeshPolicy
+		*/
+
+		assertSnapshotMeshpolicies := func(expectMeshpolicies MeshPolicyList, unexpectMeshpolicies MeshPolicyList) {
+		drain:
+			for {
+				select {
+				case snap = <-snapshots:
+					for _, expected := range expectMeshpolicies {
+						if _, err := snap.Meshpolicies.List().Find(expected.Metadata.Ref().Strings()); err != nil {
+							continue drain
+						}
+					}
+					for _, unexpected := range unexpectMeshpolicies {
+						if _, err := snap.Meshpolicies.List().Find(unexpected.Metadata.Ref().Strings()); err == nil {
+							continue drain
+						}
+					}
+					break drain
+				case err := <-errs:
+					Expect(err).NotTo(HaveOccurred())
+				case <-time.After(time.Second * 10):
+					nsList1, _ := meshPolicyClient.List(namespace1, clients.ListOpts{})
+					nsList2, _ := meshPolicyClient.List(namespace2, clients.ListOpts{})
+					combined := nsList1.ByNamespace()
+					combined.Add(nsList2...)
+					Fail("expected final snapshot before 10 seconds. expected " + log.Sprintf("%v", combined))
+				}
+			}
+		}
+
+		meshPolicy1a, err := meshPolicyClient.Write(NewMeshPolicy(namespace1, "angela"), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		meshPolicy1b, err := meshPolicyClient.Write(NewMeshPolicy(namespace2, "angela"), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotMeshpolicies(MeshPolicyList{meshPolicy1a, meshPolicy1b}, nil)
+
+		meshPolicy2a, err := meshPolicyClient.Write(NewMeshPolicy(namespace1, "bob"), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		meshPolicy2b, err := meshPolicyClient.Write(NewMeshPolicy(namespace2, "bob"), clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotMeshpolicies(MeshPolicyList{meshPolicy1a, meshPolicy1b, meshPolicy2a, meshPolicy2b}, nil)
+
+		err = meshPolicyClient.Delete(meshPolicy2a.Metadata.Namespace, meshPolicy2a.Metadata.Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = meshPolicyClient.Delete(meshPolicy2b.Metadata.Namespace, meshPolicy2b.Metadata.Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotMeshpolicies(MeshPolicyList{meshPolicy1a, meshPolicy1b}, MeshPolicyList{meshPolicy2a, meshPolicy2b})
+
+		err = meshPolicyClient.Delete(meshPolicy1a.Metadata.Namespace, meshPolicy1a.Metadata.Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		err = meshPolicyClient.Delete(meshPolicy1b.Metadata.Namespace, meshPolicy1b.Metadata.Name, clients.DeleteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+
+		assertSnapshotMeshpolicies(nil, MeshPolicyList{meshPolicy1a, meshPolicy1b, meshPolicy2a, meshPolicy2b})
+
 		/*
 			RoutingRule
 		*/
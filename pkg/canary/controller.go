@@ -0,0 +1,375 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/contextutils"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"go.uber.org/multierr"
+)
+
+const (
+	defaultMaxWeight        = 100
+	defaultInterval         = time.Minute
+	defaultFailureThreshold = 1
+)
+
+// Controller progresses the canaries in its namespaces: at every interval of a canary, it analyzes the metrics
+// of the canary upstream and advances, pauses or rolls back the traffic shifting of the routing rule of the canary.
+// the routing rules it writes are labeled with its write selector, the ones without a canary are removed
+type Controller struct {
+	Namespaces []string
+	// for reconciling only our routing rules
+	WriteSelector         map[string]string
+	CanaryClient          v1.CanaryClient
+	MeshClient            v1.MeshClient
+	UpstreamClient        gloov1.UpstreamClient
+	RoutingRuleClient     v1.RoutingRuleClient
+	RoutingRuleReconciler v1.RoutingRuleReconciler
+	Reporter              reporter.Reporter
+	Metrics               MetricsClient
+	// the clock of the controller, tests replace it to skip intervals
+	Now func() time.Time
+}
+
+func NewController(namespaces []string,
+	writeSelector map[string]string, // for reconciling only our routing rules
+	canaryClient v1.CanaryClient,
+	meshClient v1.MeshClient,
+	upstreamClient gloov1.UpstreamClient,
+	routingRuleClient v1.RoutingRuleClient,
+	reporter reporter.Reporter) *Controller {
+	if writeSelector == nil {
+		writeSelector = map[string]string{"reconciler.solo.io": "supergloo.canary"}
+	}
+	return &Controller{
+		Namespaces:            namespaces,
+		WriteSelector:         writeSelector,
+		CanaryClient:          canaryClient,
+		MeshClient:            meshClient,
+		UpstreamClient:        upstreamClient,
+		RoutingRuleClient:     routingRuleClient,
+		RoutingRuleReconciler: v1.NewRoutingRuleReconciler(routingRuleClient),
+		Reporter:              reporter,
+		Metrics:               NewPrometheusClient(),
+		Now:                   time.Now,
+	}
+}
+
+// Run syncs the canaries at every tick until the context is cancelled
+func (c *Controller) Run(ctx context.Context, tick time.Duration) <-chan error {
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.Sync(ctx); err != nil {
+					select {
+					case errs <- err:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+	return errs
+}
+
+func (c *Controller) Sync(ctx context.Context) error {
+	ctx = contextutils.WithLogger(ctx, "canary-controller")
+	logger := contextutils.LoggerFrom(ctx)
+
+	var errs error
+	resourceErrs := make(reporter.ResourceErrors)
+	for _, namespace := range c.Namespaces {
+		canaries, err := c.CanaryClient.List(namespace, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "listing canaries in %v", namespace))
+			continue
+		}
+		var rules v1.RoutingRuleList
+		for _, canary := range canaries {
+			logger.Debugf("syncing canary %v", canary.Metadata.Ref())
+			synced, rule, err := c.syncCanary(ctx, canary)
+			if rule != nil {
+				rules = append(rules, rule)
+			}
+			if err != nil {
+				resourceErrs.AddError(synced, err)
+				continue
+			}
+			resourceErrs.Accept(synced)
+		}
+		// the routing rules of the deleted canaries are removed, so that their traffic is not shifted anymore
+		if err := c.RoutingRuleReconciler.Reconcile(namespace, rules, preserveRoutingRule, clients.ListOpts{
+			Ctx:      ctx,
+			Selector: c.WriteSelector,
+		}); err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "reconciling the routing rules of the canaries in %v", namespace))
+		}
+	}
+	if err := c.Reporter.WriteReports(ctx, resourceErrs, nil); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, "writing canary reports"))
+	}
+	return errs
+}
+
+// returns the canary as last written, so that its status can be reported, and its desired routing rule.
+// the routing rule of a canary which cannot be synced is left as is, if the controller wrote it
+func (c *Controller) syncCanary(ctx context.Context, canary *v1.Canary) (*v1.Canary, *v1.RoutingRule, error) {
+	existing, err := c.RoutingRuleClient.Read(canary.Metadata.Namespace, canary.Metadata.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil && !errors.IsNotExist(err) {
+		return canary, nil, errors.Wrapf(err, "reading the routing rule of the canary")
+	}
+	if err == nil && !c.owned(existing) {
+		return canary, nil, errors.Errorf("routing rule %v was not written for the canary, it is not overwritten",
+			existing.Metadata.Ref().Key())
+	}
+
+	mesh, upstream, err := c.validateCanary(ctx, canary)
+	if err != nil {
+		return canary, existing, err
+	}
+
+	now := c.Now()
+	progress, err := c.nextProgress(ctx, mesh, canary, upstream, now)
+	if err != nil {
+		return canary, existing, err
+	}
+	if progress != nil {
+		progress.LastStep, err = types.TimestampProto(now)
+		if err != nil {
+			return canary, existing, err
+		}
+		canary.Progress = progress
+		written, err := c.CanaryClient.Write(canary, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		if err != nil {
+			return canary, existing, errors.Wrapf(err, "writing the progress of the canary")
+		}
+		canary = written
+	}
+
+	// the routing rule is reconciled at every sync, so that changes to it are reverted
+	return canary, routingRule(canary, c.WriteSelector), nil
+}
+
+// whether the routing rule has the labels of the routing rules written by the controller
+func (c *Controller) owned(rule *v1.RoutingRule) bool {
+	for k, v := range c.WriteSelector {
+		if rule.Metadata.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// returns the progress of the canary after the next step, or nil if it is not time for a step yet
+func (c *Controller) nextProgress(ctx context.Context, mesh *v1.Mesh, canary *v1.Canary, upstream *gloov1.Upstream, now time.Time) (*v1.CanaryProgress, error) {
+	if canary.Progress.GetLastStep() == nil {
+		// the canary has no traffic to analyze yet
+		return &v1.CanaryProgress{
+			Phase:        v1.CanaryProgress_Progressing,
+			CanaryWeight: canary.StepWeight,
+			Message:      fmt.Sprintf("shifted %v%% of the traffic to the canary", canary.StepWeight),
+		}, nil
+	}
+	progress := *canary.Progress
+	switch progress.Phase {
+	case v1.CanaryProgress_Promoted, v1.CanaryProgress_RolledBack:
+		return nil, nil
+	}
+	lastStep, err := types.TimestampFromProto(progress.LastStep)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := canaryInterval(canary)
+	if err != nil {
+		return nil, err
+	}
+	if now.Before(lastStep.Add(interval)) {
+		return nil, nil
+	}
+
+	failure, err := c.analyze(ctx, mesh, canary, upstream, interval)
+	switch {
+	case err != nil:
+		// e.g. no requests have reached the canary during the interval, try again at the next one
+		progress.Phase = v1.CanaryProgress_Paused
+		progress.Message = fmt.Sprintf("the analysis could not be completed: %v", err)
+	case failure != "":
+		progress.FailedAnalyses++
+		threshold := canary.Analysis.GetFailureThreshold()
+		if threshold == 0 {
+			threshold = defaultFailureThreshold
+		}
+		if progress.FailedAnalyses >= threshold {
+			progress.Phase = v1.CanaryProgress_RolledBack
+			progress.CanaryWeight = 0
+			progress.Message = fmt.Sprintf("rolled back after %v failed analyses: %v", progress.FailedAnalyses, failure)
+		} else {
+			progress.Phase = v1.CanaryProgress_Paused
+			progress.Message = fmt.Sprintf("the analysis failed: %v", failure)
+		}
+	default:
+		progress.FailedAnalyses = 0
+		maxWeight := canaryMaxWeight(canary)
+		if progress.CanaryWeight >= maxWeight {
+			progress.Phase = v1.CanaryProgress_Promoted
+			progress.CanaryWeight = 100
+			progress.Message = "promoted the canary"
+			break
+		}
+		progress.Phase = v1.CanaryProgress_Progressing
+		progress.CanaryWeight += canary.StepWeight
+		if progress.CanaryWeight > maxWeight {
+			progress.CanaryWeight = maxWeight
+		}
+		progress.Message = fmt.Sprintf("shifted %v%% of the traffic to the canary", progress.CanaryWeight)
+	}
+	return &progress, nil
+}
+
+// returns a description of the limit exceeded by the canary, or an empty string if the analysis passed
+func (c *Controller) analyze(ctx context.Context, mesh *v1.Mesh, canary *v1.Canary, upstream *gloov1.Upstream, interval time.Duration) (string, error) {
+	maxErrorRate, maxLatency := canary.Analysis.GetMaxErrorRate(), canary.Analysis.GetMaxLatency()
+	if maxErrorRate == nil && maxLatency == nil {
+		return "", nil
+	}
+	url, err := prometheusUrl(mesh, canary)
+	if err != nil {
+		return "", err
+	}
+	window := fmt.Sprintf("%vs", int64(interval.Seconds()))
+	errorRateQuery, latencyQuery, err := analysisQueries(mesh, canary, upstream, window)
+	if err != nil {
+		return "", err
+	}
+	if maxErrorRate != nil {
+		errorRate, err := c.Metrics.Query(ctx, url, errorRateQuery)
+		if err != nil {
+			return "", errors.Wrapf(err, "querying the error rate")
+		}
+		if errorRate > maxErrorRate.Value {
+			return fmt.Sprintf("error rate of %.2f%% exceeds %v%%", errorRate, maxErrorRate.Value), nil
+		}
+	}
+	if maxLatency != nil {
+		max, err := types.DurationFromProto(maxLatency)
+		if err != nil {
+			return "", err
+		}
+		latencyMs, err := c.Metrics.Query(ctx, url, latencyQuery)
+		if err != nil {
+			return "", errors.Wrapf(err, "querying the latency")
+		}
+		if latency := time.Duration(latencyMs * float64(time.Millisecond)); latency > max {
+			return fmt.Sprintf("latency of %v exceeds %v", latency, max), nil
+		}
+	}
+	return "", nil
+}
+
+// returns the target mesh and the canary upstream
+func (c *Controller) validateCanary(ctx context.Context, canary *v1.Canary) (*v1.Mesh, *gloov1.Upstream, error) {
+	if canary.TargetMesh == nil {
+		return nil, nil, errors.Errorf("target mesh is required")
+	}
+	if canary.Primary == nil || canary.Canary == nil {
+		return nil, nil, errors.Errorf("primary and canary upstreams are required")
+	}
+	if canary.Primary.Equal(canary.Canary) {
+		return nil, nil, errors.Errorf("primary and canary must be different upstreams")
+	}
+	if canary.StepWeight == 0 || canary.StepWeight > 100 {
+		return nil, nil, errors.Errorf("step weight must be between 1 and 100")
+	}
+	if canary.MaxWeight > 100 {
+		return nil, nil, errors.Errorf("max weight must be between 0 and 100")
+	}
+	if interval, err := canaryInterval(canary); err != nil || interval <= 0 {
+		return nil, nil, errors.Errorf("interval must be a positive duration")
+	}
+	mesh, err := c.MeshClient.Read(canary.TargetMesh.Namespace, canary.TargetMesh.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "reading target mesh")
+	}
+	if _, err := c.UpstreamClient.Read(canary.Primary.Namespace, canary.Primary.Name, clients.ReadOpts{Ctx: ctx}); err != nil {
+		return nil, nil, errors.Wrapf(err, "reading primary upstream")
+	}
+	upstream, err := c.UpstreamClient.Read(canary.Canary.Namespace, canary.Canary.Name, clients.ReadOpts{Ctx: ctx})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "reading canary upstream")
+	}
+	if canary.Analysis.GetMaxErrorRate() != nil || canary.Analysis.GetMaxLatency() != nil {
+		if _, err := prometheusUrl(mesh, canary); err != nil {
+			return nil, nil, err
+		}
+		if _, _, err := analysisQueries(mesh, canary, upstream, ""); err != nil {
+			return nil, nil, err
+		}
+	}
+	return mesh, upstream, nil
+}
+
+func preserveRoutingRule(original, desired *v1.RoutingRule) (bool, error) {
+	original.Metadata = desired.Metadata
+	original.Status = desired.Status
+	return !original.Equal(desired), nil
+}
+
+// the routing rule shifting the traffic to the primary between the primary and the canary
+func routingRule(canary *v1.Canary, writeSelector map[string]string) *v1.RoutingRule {
+	primary, canaryUpstream := *canary.Primary, *canary.Canary
+	canaryWeight := canary.Progress.GetCanaryWeight()
+	// weights must be greater than zero
+	var destinations []*v1.WeightedDestination
+	if canaryWeight < 100 {
+		destinations = append(destinations, &v1.WeightedDestination{Upstream: &primary, Weight: 100 - canaryWeight})
+	}
+	if canaryWeight > 0 {
+		destinations = append(destinations, &v1.WeightedDestination{Upstream: &canaryUpstream, Weight: canaryWeight})
+	}
+	targetMesh := *canary.TargetMesh
+	labels := make(map[string]string)
+	for k, v := range writeSelector {
+		labels[k] = v
+	}
+	return &v1.RoutingRule{
+		Metadata: core.Metadata{
+			Name:      canary.Metadata.Name,
+			Namespace: canary.Metadata.Namespace,
+			Labels:    labels,
+		},
+		TargetMesh:      &targetMesh,
+		Destinations:    []*core.ResourceRef{&primary},
+		TrafficShifting: &v1.TrafficShifting{Destinations: destinations},
+	}
+}
+
+func canaryInterval(canary *v1.Canary) (time.Duration, error) {
+	if canary.Interval == nil {
+		return defaultInterval, nil
+	}
+	return types.DurationFromProto(canary.Interval)
+}
+
+func canaryMaxWeight(canary *v1.Canary) uint32 {
+	if canary.MaxWeight == 0 {
+		return defaultMaxWeight
+	}
+	return canary.MaxWeight
+}
@@ -0,0 +1,336 @@
+package canary_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/canary"
+)
+
+const namespace = "supergloo-system"
+
+var _ = Describe("Controller", func() {
+	var (
+		prometheus *httptest.Server
+		// the value returned by the fake prometheus for each metric, no data if missing
+		metrics    map[string]string
+		now        time.Time
+		controller *Controller
+
+		canaryClient v1.CanaryClient
+		meshClient   v1.MeshClient
+		ruleClient   v1.RoutingRuleClient
+	)
+
+	BeforeEach(func() {
+		metrics = map[string]string{}
+		prometheus = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1/query"))
+			query := r.URL.Query().Get("query")
+			var result string
+			for metric, value := range metrics {
+				if strings.Contains(query, metric) {
+					result = fmt.Sprintf(`{"metric":{},"value":[1545000000,"%v"]}`, value)
+				}
+			}
+			fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%v]}}`, result)
+		}))
+
+		cache := memory.NewInMemoryResourceCache()
+		rcFactory := &factory.MemoryResourceClientFactory{Cache: cache}
+		var err error
+		canaryClient, err = v1.NewCanaryClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+		meshClient, err = v1.NewMeshClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+		ruleClient, err = v1.NewRoutingRuleClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+		upstreamClient, err := gloov1.NewUpstreamClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = meshClient.Write(&v1.Mesh{
+			Metadata: core.Metadata{Name: "istio", Namespace: namespace},
+			MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+			Observability: &v1.Observability{
+				Prometheus: &v1.Prometheus{Url: prometheus.URL},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		for _, version := range []string{"v1", "v2"} {
+			_, err = upstreamClient.Write(&gloov1.Upstream{
+				Metadata: core.Metadata{Name: "reviews-" + version, Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      "reviews",
+							ServiceNamespace: "default",
+							ServicePort:      9080,
+							Selector:         map[string]string{"version": version},
+						},
+					},
+				},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		now = time.Unix(1545000000, 0)
+		controller = NewController([]string{namespace}, nil, canaryClient, meshClient, upstreamClient, ruleClient,
+			reporter.NewReporter("supergloo", canaryClient.BaseClient()))
+		controller.Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		prometheus.Close()
+	})
+
+	writeCanary := func(mutate func(canary *v1.Canary)) {
+		canary := &v1.Canary{
+			Metadata:   core.Metadata{Name: "reviews", Namespace: namespace},
+			TargetMesh: &core.ResourceRef{Name: "istio", Namespace: namespace},
+			Primary:    &core.ResourceRef{Name: "reviews-v1", Namespace: namespace},
+			Canary:     &core.ResourceRef{Name: "reviews-v2", Namespace: namespace},
+			StepWeight: 10,
+			Interval:   types.DurationProto(time.Minute),
+			Analysis: &v1.CanaryAnalysis{
+				MaxErrorRate: &types.DoubleValue{Value: 1},
+				MaxLatency:   types.DurationProto(500 * time.Millisecond),
+			},
+		}
+		if mutate != nil {
+			mutate(canary)
+		}
+		_, err := canaryClient.Write(canary, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// syncs once the interval of the canary has elapsed
+	step := func() *v1.Canary {
+		err := controller.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		now = now.Add(time.Minute)
+		canary, err := canaryClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return canary
+	}
+
+	weights := func() map[string]uint32 {
+		rule, err := ruleClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule.Destinations).To(Equal([]*core.ResourceRef{{Name: "reviews-v1", Namespace: namespace}}))
+		weights := map[string]uint32{}
+		for _, dest := range rule.TrafficShifting.Destinations {
+			weights[dest.Upstream.Name] = dest.Weight
+		}
+		return weights
+	}
+
+	healthy := func() {
+		metrics["istio_requests_total"] = "0.5"
+		metrics["istio_request_duration_seconds_bucket"] = "120"
+	}
+
+	It("advances the canary while its metrics are healthy and promotes it at the max weight", func() {
+		writeCanary(func(canary *v1.Canary) {
+			canary.MaxWeight = 20
+		})
+		healthy()
+
+		canary := step()
+		Expect(canary.Status.State).To(Equal(core.Status_Accepted))
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Progressing))
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(10))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 90, "reviews-v2": 10}))
+
+		canary = step()
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(20))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 80, "reviews-v2": 20}))
+
+		canary = step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Promoted))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v2": 100}))
+
+		// nothing left to do
+		canary = step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Promoted))
+	})
+
+	It("waits for the interval of the canary between steps", func() {
+		writeCanary(nil)
+		healthy()
+
+		step()
+		now = now.Add(-30 * time.Second)
+		canary := step()
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(10))
+
+		canary = step()
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(20))
+	})
+
+	It("queries the istio metrics of the canary version", func() {
+		writeCanary(nil)
+		metrics[`destination_service="reviews.default.svc.cluster.local",destination_version="v2"`] = "0"
+
+		step()
+		canary := step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Progressing))
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(20))
+	})
+
+	It("pauses the canary when the analysis fails and rolls it back at the failure threshold", func() {
+		writeCanary(func(canary *v1.Canary) {
+			canary.Analysis.FailureThreshold = 2
+		})
+		healthy()
+		step()
+
+		metrics["istio_requests_total"] = "5"
+		canary := step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Paused))
+		Expect(canary.Progress.FailedAnalyses).To(BeEquivalentTo(1))
+		Expect(canary.Progress.Message).To(ContainSubstring("error rate of 5.00% exceeds 1%"))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 90, "reviews-v2": 10}))
+
+		healthy()
+		metrics["istio_request_duration_seconds_bucket"] = "800"
+		canary = step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_RolledBack))
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(0))
+		Expect(canary.Progress.Message).To(ContainSubstring("latency of 800ms exceeds 500ms"))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 100}))
+	})
+
+	It("pauses the canary while prometheus has no data for it", func() {
+		writeCanary(nil)
+		step()
+
+		canary := step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Paused))
+		Expect(canary.Progress.FailedAnalyses).To(BeEquivalentTo(0))
+		Expect(canary.Progress.Message).To(ContainSubstring(NoDataError.Error()))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 90, "reviews-v2": 10}))
+
+		healthy()
+		canary = step()
+		Expect(canary.Progress.Phase).To(Equal(v1.CanaryProgress_Progressing))
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(20))
+	})
+
+	It("rejects canaries which cannot be analyzed", func() {
+		_, err := meshClient.Write(&v1.Mesh{
+			Metadata: core.Metadata{Name: "linkerd", Namespace: namespace},
+			MeshType: &v1.Mesh_Linkerd2{Linkerd2: &v1.Linkerd2{}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		writeCanary(func(canary *v1.Canary) {
+			canary.TargetMesh.Name = "linkerd"
+			canary.PrometheusUrl = prometheus.URL
+		})
+
+		canary := step()
+		Expect(canary.Status.State).To(Equal(core.Status_Rejected))
+		Expect(canary.Status.Reason).To(ContainSubstring("queries are required"))
+		Expect(canary.Progress).To(BeNil())
+		_, err = ruleClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("queries the prometheus of the canary rather than the one of its mesh", func() {
+		mesh, err := meshClient.Read(namespace, "istio", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		mesh.Observability = nil
+		_, err = meshClient.Write(mesh, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		writeCanary(nil)
+
+		canary := step()
+		Expect(canary.Status.State).To(Equal(core.Status_Rejected))
+		Expect(canary.Status.Reason).To(ContainSubstring("a prometheus url is required for the analysis"))
+		Expect(canary.Progress).To(BeNil())
+
+		canary.PrometheusUrl = prometheus.URL
+		_, err = canaryClient.Write(canary, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		healthy()
+		step()
+		canary = step()
+		Expect(canary.Status.State).To(Equal(core.Status_Accepted))
+		Expect(canary.Progress.CanaryWeight).To(BeEquivalentTo(20))
+	})
+
+	It("removes the routing rule of a canary once it is deleted", func() {
+		writeCanary(nil)
+		healthy()
+		step()
+		rule, err := ruleClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule.Metadata.Labels).To(Equal(map[string]string{"reconciler.solo.io": "supergloo.canary"}))
+
+		// written by a user
+		_, err = ruleClient.Write(&v1.RoutingRule{
+			Metadata:   core.Metadata{Name: "ratings", Namespace: namespace},
+			TargetMesh: &core.ResourceRef{Name: "istio", Namespace: namespace},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = canaryClient.Delete(namespace, "reviews", clients.DeleteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		err = controller.Sync(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ruleClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).To(HaveOccurred())
+		_, err = ruleClient.Read(namespace, "ratings", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not overwrite a routing rule it did not write", func() {
+		userRule := &v1.RoutingRule{
+			Metadata:     core.Metadata{Name: "reviews", Namespace: namespace},
+			TargetMesh:   &core.ResourceRef{Name: "istio", Namespace: namespace},
+			Destinations: []*core.ResourceRef{{Name: "reviews-v1", Namespace: namespace}},
+		}
+		_, err := ruleClient.Write(userRule, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		writeCanary(nil)
+		healthy()
+
+		canary := step()
+		Expect(canary.Status.State).To(Equal(core.Status_Rejected))
+		Expect(canary.Status.Reason).To(ContainSubstring("routing rule supergloo-system.reviews was not written for the canary"))
+		Expect(canary.Progress).To(BeNil())
+		rule, err := ruleClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule.TrafficShifting).To(BeNil())
+		Expect(rule.Metadata.Labels).To(BeEmpty())
+	})
+
+	It("leaves the routing rule of a canary which becomes invalid as is", func() {
+		writeCanary(nil)
+		healthy()
+		step()
+
+		canary, err := canaryClient.Read(namespace, "reviews", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		canary.StepWeight = 0
+		_, err = canaryClient.Write(canary, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+		canary = step()
+		Expect(canary.Status.State).To(Equal(core.Status_Rejected))
+		Expect(weights()).To(Equal(map[string]uint32{"reviews-v1": 90, "reviews-v2": 10}))
+	})
+})
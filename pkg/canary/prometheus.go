@@ -0,0 +1,124 @@
+package canary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/solo-io/solo-kit/pkg/errors"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// returned when a query succeeds but has no samples, e.g. when the canary has not received any requests yet
+var NoDataError = errors.Errorf("the query returned no data")
+
+// MetricsClient evaluates the queries of the canary analysis
+type MetricsClient interface {
+	// returns the value of the first sample of an instant query
+	Query(ctx context.Context, prometheusUrl, query string) (float64, error)
+}
+
+// PrometheusClient queries the http api of a prometheus server
+type PrometheusClient struct {
+	Http *http.Client
+}
+
+func NewPrometheusClient() *PrometheusClient {
+	return &PrometheusClient{Http: http.DefaultClient}
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			// [ <unix time>, "<sample value>" ]
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (c *PrometheusClient) Query(ctx context.Context, prometheusUrl, query string) (float64, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(prometheusUrl, "/")+"/api/v1/query?query="+url.QueryEscape(query), nil)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.Http.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrapf(err, "querying prometheus at %v", prometheusUrl)
+	}
+	defer res.Body.Close()
+
+	var body queryResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return 0, errors.Wrapf(err, "decoding the response of prometheus (%v)", res.Status)
+	}
+	if body.Status != "success" {
+		return 0, errors.Errorf("prometheus query %v failed: %v", query, body.Error)
+	}
+	if len(body.Data.Result) == 0 || len(body.Data.Result[0].Value) != 2 {
+		return 0, NoDataError
+	}
+	sample, ok := body.Data.Result[0].Value[1].(string)
+	if !ok {
+		return 0, errors.Errorf("unexpected sample %v in the result of prometheus query %v", body.Data.Result[0].Value[1], query)
+	}
+	value, err := strconv.ParseFloat(sample, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parsing the result of prometheus query %v", query)
+	}
+	// rates of a service without requests divide by zero
+	if value != value {
+		return 0, NoDataError
+	}
+	return value, nil
+}
+
+// the address of the prometheus server queried for the metrics of the canary: its own, or the one of its mesh
+func prometheusUrl(mesh *v1.Mesh, canary *v1.Canary) (string, error) {
+	if canary.PrometheusUrl != "" {
+		return canary.PrometheusUrl, nil
+	}
+	if url := mesh.GetObservability().GetPrometheus().GetUrl(); url != "" {
+		return url, nil
+	}
+	return "", errors.Errorf("a prometheus url is required for the analysis, neither the canary nor its mesh %v has one",
+		mesh.Metadata.Ref().Key())
+}
+
+const (
+	istioErrorRateQuery = `100 * sum(rate(istio_requests_total{reporter="destination",%[1]v,response_code=~"5.."}[%[2]v]))` +
+		` / sum(rate(istio_requests_total{reporter="destination",%[1]v}[%[2]v]))`
+	istioLatencyQuery = `1000 * histogram_quantile(0.99, sum(rate(istio_request_duration_seconds_bucket{reporter="destination",%[1]v}[%[2]v])) by (le))`
+)
+
+// the queries for the error rate and the latency of the canary, defaults are only available for istio
+func analysisQueries(mesh *v1.Mesh, canary *v1.Canary, upstream *gloov1.Upstream, window string) (string, string, error) {
+	errorRateQuery, latencyQuery := canary.Analysis.GetErrorRateQuery(), canary.Analysis.GetLatencyQuery()
+	if errorRateQuery != "" && latencyQuery != "" {
+		return errorRateQuery, latencyQuery, nil
+	}
+	if mesh.GetIstio() == nil {
+		return "", "", errors.Errorf("the error rate and latency queries are required for meshes other than istio")
+	}
+	kube, ok := upstream.GetUpstreamSpec().GetUpstreamType().(*gloov1.UpstreamSpec_Kube)
+	if !ok {
+		return "", "", errors.Errorf("default queries are only available for kubernetes upstreams")
+	}
+	labels := fmt.Sprintf(`destination_service="%v.%v.svc.cluster.local"`, kube.Kube.ServiceName, kube.Kube.ServiceNamespace)
+	if version, ok := kube.Kube.Selector["version"]; ok {
+		labels += fmt.Sprintf(`,destination_version="%v"`, version)
+	}
+	if errorRateQuery == "" {
+		errorRateQuery = fmt.Sprintf(istioErrorRateQuery, labels, window)
+	}
+	if latencyQuery == "" {
+		latencyQuery = fmt.Sprintf(istioLatencyQuery, labels, window)
+	}
+	return errorRateQuery, latencyQuery, nil
+}
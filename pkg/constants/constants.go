@@ -2,8 +2,12 @@ package constants
 
 var (
 	SuperglooNamespace = "supergloo-system"
+	// namespace of the upstreams discovered by gloo
+	GlooNamespace      = "gloo-system"
 	MeshOptions        = []string{"istio", "consul", "linkerd2"}
 	ConsulInstallPath  = "https://s3.amazonaws.com/supergloo.solo.io/consul.tar.gz"
 	IstioInstallPath   = "https://s3.amazonaws.com/supergloo.solo.io/istio-1.0.3.tgz"
 	LinkerdInstallPath = "https://s3.amazonaws.com/supergloo.solo.io/linkerd2-0.1.1.tgz"
+	// annotation of the meshes created by an install with the name of their helm release
+	HelmReleaseAnnotation = "helm_release"
 )
@@ -14,10 +14,13 @@ import (
 
 	"github.com/solo-io/supergloo/pkg/install/istio"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/constants"
 	"github.com/solo-io/supergloo/pkg/install/consul"
 	"github.com/solo-io/supergloo/pkg/install/helm"
 	"k8s.io/client-go/kubernetes"
@@ -32,14 +35,14 @@ import (
 	istiov1 "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
 )
 
-const releaseNameKey = "helm_release"
-
 type InstallSyncer struct {
 	Kube           *kubernetes.Clientset
 	MeshClient     v1.MeshClient
 	SecurityClient *security.Clientset
 	ApiExts        apiexts.Interface
 	SecretClient   istiov1.IstioCacertsSecretClient
+	// writes the status of each install, may be nil
+	Reporter reporter.Reporter
 }
 
 type MeshInstaller interface {
@@ -53,13 +56,22 @@ type MeshInstaller interface {
 func (syncer *InstallSyncer) Sync(ctx context.Context, snap *v1.InstallSnapshot) error {
 	secretList := snap.Istiocerts.List()
 	ctx = contextutils.WithLogger(ctx, "install-syncer")
+	// installs are synced independently so one bad install cannot block the others
+	resourceErrs := make(reporter.ResourceErrors)
+	var multiErr *multierror.Error
 	for _, install := range snap.Installs.List() {
-		err := syncer.syncInstall(ctx, install, secretList)
-		if err != nil {
-			return err
+		resourceErrs.Accept(install)
+		if err := syncer.syncInstall(ctx, install, secretList); err != nil {
+			resourceErrs.AddError(install, err)
+			multiErr = multierror.Append(multiErr, errors.Wrapf(err, "syncing install %v failed", install.Metadata.Ref()))
 		}
 	}
-	return nil
+	if syncer.Reporter != nil {
+		if err := syncer.Reporter.WriteReports(ctx, resourceErrs, nil); err != nil {
+			multiErr = multierror.Append(multiErr, errors.Wrapf(err, "writing install reports"))
+		}
+	}
+	return multiErr.ErrorOrNil()
 }
 
 func (syncer *InstallSyncer) syncInstall(ctx context.Context, install *v1.Install, secretList istiov1.IstioCacertsSecretList) error {
@@ -266,7 +278,7 @@ func getMeshObject(install *v1.Install, releaseName string) (*v1.Mesh, error) {
 		Metadata: core.Metadata{
 			Name:        install.Metadata.Name,
 			Namespace:   install.Metadata.Namespace,
-			Annotations: map[string]string{releaseNameKey: releaseName},
+			Annotations: map[string]string{constants.HelmReleaseAnnotation: releaseName},
 		},
 		Encryption: install.Encryption,
 	}
@@ -291,7 +303,7 @@ func getMeshObject(install *v1.Install, releaseName string) (*v1.Mesh, error) {
 }
 
 func (syncer *InstallSyncer) uninstallHelmRelease(ctx context.Context, mesh *v1.Mesh, install *v1.Install, meshInstaller MeshInstaller) error {
-	releaseName := mesh.Metadata.Annotations[releaseNameKey]
+	releaseName := mesh.Metadata.Annotations[constants.HelmReleaseAnnotation]
 	helmClient, err := helm.GetHelmClient(ctx)
 	if err != nil {
 		return err
@@ -0,0 +1,63 @@
+package install_test
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/install"
+)
+
+var _ = Describe("InstallSyncer", func() {
+	It("syncs every install and reports the status of each", func() {
+		memoryFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		installClient, err := v1.NewInstallClient(memoryFactory)
+		Expect(err).NotTo(HaveOccurred())
+		err = installClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		meshClient, err := v1.NewMeshClient(memoryFactory)
+		Expect(err).NotTo(HaveOccurred())
+		err = meshClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+
+		// no mesh type, cannot be installed
+		invalid, err := installClient.Write(&v1.Install{
+			Metadata: core.Metadata{Name: "invalid", Namespace: "supergloo-system"},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		// disabled and never installed, nothing to do
+		disabled, err := installClient.Write(&v1.Install{
+			Metadata: core.Metadata{Name: "valid", Namespace: "supergloo-system"},
+			MeshType: &v1.Install_Consul{Consul: &v1.Consul{}},
+			Enabled:  &types.BoolValue{Value: false},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		s := &InstallSyncer{
+			MeshClient: meshClient,
+			Reporter:   reporter.NewReporter("supergloo", installClient.BaseClient()),
+		}
+		err = s.Sync(context.TODO(), &v1.InstallSnapshot{
+			Installs: map[string]v1.InstallList{"": {invalid, disabled}},
+		})
+		Expect(err).To(HaveOccurred())
+
+		invalid, err = installClient.Read("supergloo-system", "invalid", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(invalid.Status.State).To(Equal(core.Status_Rejected))
+		Expect(invalid.Status.Reason).To(ContainSubstring("Unsupported mesh type"))
+
+		disabled, err = installClient.Read("supergloo-system", "valid", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disabled.Status.State).To(Equal(core.Status_Accepted))
+	})
+})
@@ -21,11 +21,16 @@ import (
 	"github.com/solo-io/solo-kit/pkg/utils/errutils"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
 	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	policyv1beta1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/policy/v1beta1"
 	prometheusv1 "github.com/solo-io/supergloo/pkg/api/external/prometheus/v1"
+	splitv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/smi/split/v1alpha1"
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/canary"
+	"github.com/solo-io/supergloo/pkg/constants"
 	"github.com/solo-io/supergloo/pkg/translator/consul"
 	"github.com/solo-io/supergloo/pkg/translator/istio"
 	"github.com/solo-io/supergloo/pkg/translator/linkerd2"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -70,6 +75,47 @@ func Main(namespaces ...string) error {
 		return err
 	}
 
+	serviceProfileClient, err := linkerd2.NewKubeServiceProfileClient(restConfig)
+	if err != nil {
+		return err
+	}
+
+	trafficSplitClient, err := splitv1alpha1.NewTrafficSplitClient(&factory.KubeResourceClientFactory{
+		Crd:         splitv1alpha1.TrafficSplitCrd,
+		Cfg:         restConfig,
+		SharedCache: kubeCache,
+	})
+	if err != nil {
+		return err
+	}
+	if err := trafficSplitClient.Register(); err != nil {
+		return err
+	}
+
+	serverClient, err := policyv1beta1.NewServerClient(&factory.KubeResourceClientFactory{
+		Crd:         policyv1beta1.ServerCrd,
+		Cfg:         restConfig,
+		SharedCache: kubeCache,
+	})
+	if err != nil {
+		return err
+	}
+	if err := serverClient.Register(); err != nil {
+		return err
+	}
+
+	serverAuthorizationClient, err := policyv1beta1.NewServerAuthorizationClient(&factory.KubeResourceClientFactory{
+		Crd:         policyv1beta1.ServerAuthorizationCrd,
+		Cfg:         restConfig,
+		SharedCache: kubeCache,
+	})
+	if err != nil {
+		return err
+	}
+	if err := serverAuthorizationClient.Register(); err != nil {
+		return err
+	}
+
 	prometheusClient, err := prometheusv1.NewConfigClient(&factory.KubeConfigMapClientFactory{
 		Clientset: kubeClient,
 	})
@@ -116,6 +162,30 @@ func Main(namespaces ...string) error {
 		return err
 	}
 
+	meshPolicyClient, err := v1.NewMeshPolicyClient(&factory.KubeResourceClientFactory{
+		Crd:         v1.MeshPolicyCrd,
+		Cfg:         restConfig,
+		SharedCache: kubeCache,
+	})
+	if err != nil {
+		return err
+	}
+	if err := meshPolicyClient.Register(); err != nil {
+		return err
+	}
+
+	canaryClient, err := v1.NewCanaryClient(&factory.KubeResourceClientFactory{
+		Crd:         v1.CanaryCrd,
+		Cfg:         restConfig,
+		SharedCache: kubeCache,
+	})
+	if err != nil {
+		return err
+	}
+	if err := canaryClient.Register(); err != nil {
+		return err
+	}
+
 	upstreamClient, err := gloov1.NewUpstreamClient(&factory.KubeResourceClientFactory{
 		Crd:         gloov1.UpstreamCrd,
 		Cfg:         restConfig,
@@ -138,9 +208,9 @@ func Main(namespaces ...string) error {
 
 	installEmitter := v1.NewInstallEmitter(installClient, secretClient)
 
-	translatorEmitter := v1.NewTranslatorEmitter(meshClient, routingRuleClient, upstreamClient, secretClient)
+	translatorEmitter := v1.NewTranslatorEmitter(meshClient, meshPolicyClient, routingRuleClient, upstreamClient, secretClient)
 
-	rpt := reporter.NewReporter("supergloo", meshClient.BaseClient())
+	rpt := reporter.NewReporter("supergloo", meshClient.BaseClient(), meshPolicyClient.BaseClient(), routingRuleClient.BaseClient())
 	writeErrs := make(chan error)
 
 	istioRoutingSyncer := istio.NewMeshRoutingSyncer(namespaces,
@@ -149,28 +219,51 @@ func Main(namespaces ...string) error {
 		v1alpha3.NewVirtualServiceReconciler(virtualServiceClient),
 		rpt)
 
+	linkerd2RoutingSyncer := linkerd2.NewMeshRoutingSyncer(namespaces,
+		nil, // if we run multiple syncers, set this to prevent a conflict / race
+		serviceProfileClient,
+		splitv1alpha1.NewTrafficSplitReconciler(trafficSplitClient),
+		rpt)
+
+	linkerd2PolicySyncer := linkerd2.NewPolicySyncer(
+		nil, // if we run multiple syncers, set this to prevent a conflict / race
+		kubeClient,
+		policyv1beta1.NewServerReconciler(serverClient),
+		policyv1beta1.NewServerAuthorizationReconciler(serverAuthorizationClient))
+
 	linkerd2PrometheusSyncer := linkerd2.NewPrometheusSyncer(kubeClient, prometheusClient)
 	istioPrometheusSyncer := istio.NewPrometheusSyncer(kubeClient, prometheusClient)
 
 	consulEncryptionSyncer := &consul.ConsulSyncer{}
-	consulPolicySyncer := &consul.PolicySyncer{}
+	consulPolicySyncer := &consul.PolicySyncer{Kube: kubeClient}
+	consulRoutingSyncer := &consul.RoutingSyncer{Kube: kubeClient}
 	istioEncryptionSyncer := &istio.EncryptionSyncer{
 		Kube:         kubeClient,
 		SecretClient: secretClient,
 	}
-	istioPolicySyncer, err := istio.NewPolicySyncer("supergloo-system", kubeCache, restConfig)
+	istioPolicySyncer, err := istio.NewPolicySyncer(constants.SuperglooNamespace, kubeCache, restConfig)
 	if err != nil {
 		return err
 	}
 
+	// writes the status of each mesh after all the syncers have run
+	meshReportingSyncer := &shared.MeshReportingSyncer{
+		Reporter: rpt,
+		Syncers: v1.TranslatorSyncers{
+			istioRoutingSyncer,
+			linkerd2RoutingSyncer,
+			istioPrometheusSyncer,
+			linkerd2PrometheusSyncer,
+			consulEncryptionSyncer,
+			consulPolicySyncer,
+			consulRoutingSyncer,
+			istioEncryptionSyncer,
+			istioPolicySyncer,
+			linkerd2PolicySyncer,
+		},
+	}
 	translatorSyncers := v1.TranslatorSyncers{
-		istioRoutingSyncer,
-		istioPrometheusSyncer,
-		linkerd2PrometheusSyncer,
-		consulEncryptionSyncer,
-		consulPolicySyncer,
-		istioEncryptionSyncer,
-		istioPolicySyncer,
+		meshReportingSyncer,
 	}
 
 	apiExts, err := apiexts.NewForConfig(restConfig)
@@ -182,6 +275,7 @@ func Main(namespaces ...string) error {
 		Kube:         kubeClient,
 		MeshClient:   meshClient,
 		SecretClient: secretClient,
+		Reporter:     reporter.NewReporter("supergloo", installClient.BaseClient()),
 		// TODO: set a security client when we resolve minishift issues
 	}
 	installSyncers := v1.InstallSyncers{
@@ -191,6 +285,9 @@ func Main(namespaces ...string) error {
 	translatorEventLoop := v1.NewTranslatorEventLoop(translatorEmitter, translatorSyncers)
 	installEventLoop := v1.NewInstallEventLoop(installEmitter, installSyncers)
 
+	canaryController := canary.NewController(namespaces, nil, canaryClient, meshClient, upstreamClient, routingRuleClient,
+		reporter.NewReporter("supergloo", canaryClient.BaseClient()))
+
 	ctx := contextutils.WithLogger(context.Background(), "supergloo")
 	watchOpts := clients.WatchOpts{
 		Ctx:         ctx,
@@ -209,6 +306,9 @@ func Main(namespaces ...string) error {
 	}
 	go errutils.AggregateErrs(watchOpts.Ctx, writeErrs, installEventLoopErrs, "install_event_loop")
 
+	canaryControllerErrs := canaryController.Run(watchOpts.Ctx, watchOpts.RefreshRate)
+	go errutils.AggregateErrs(watchOpts.Ctx, writeErrs, canaryControllerErrs, "canary_controller")
+
 	logger := contextutils.LoggerFrom(watchOpts.Ctx)
 
 	for {
@@ -0,0 +1,39 @@
+package consul
+
+import (
+	"github.com/hashicorp/consul/api"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// the key of the acl token in the secret referenced by the mesh
+const aclTokenKey = "token"
+
+// the config of the client for the consul api of the mesh: its server address and,
+// if the mesh references one, the acl token read from the kubernetes secret
+func consulConfig(kube kubernetes.Interface, mesh *v1.Consul) (*api.Config, error) {
+	config := api.DefaultConfig()
+	if mesh.ServerAddress != "" {
+		config.Address = mesh.ServerAddress
+	}
+	ref := mesh.AclTokenSecret
+	if ref == nil {
+		return config, nil
+	}
+	if kube == nil {
+		return nil, errors.Errorf("cannot read the acl token secret %v without a kubernetes client", ref.Key())
+	}
+	secret, err := kube.CoreV1().Secrets(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading the acl token secret %v", ref.Key())
+	}
+	token, ok := secret.Data[aclTokenKey]
+	if !ok {
+		return nil, errors.Errorf("acl token secret %v has no %v key", ref.Key(), aclTokenKey)
+	}
+	config.Token = string(token)
+	return config, nil
+}
@@ -0,0 +1,213 @@
+package consul
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+// L7 traffic management config entries, as introduced in consul 1.6.
+// the vendored consul api predates config entries, so they are modeled here
+// and written through the raw api
+const (
+	ServiceRouter   = "service-router"
+	ServiceSplitter = "service-splitter"
+	ServiceResolver = "service-resolver"
+
+	// config entries have no metadata, so the entries we own are tracked in the kv store.
+	// each key holds the last written entry so unchanged entries are not rewritten
+	configEntriesKvPrefix = "supergloo/config-entries/"
+)
+
+type ConfigEntry interface {
+	GetKind() string
+	GetName() string
+}
+
+type ServiceRouterConfigEntry struct {
+	Kind   string
+	Name   string
+	Routes []ServiceRoute `json:",omitempty"`
+}
+
+func (e *ServiceRouterConfigEntry) GetKind() string { return e.Kind }
+func (e *ServiceRouterConfigEntry) GetName() string { return e.Name }
+
+type ServiceRoute struct {
+	Match       *ServiceRouteMatch       `json:",omitempty"`
+	Destination *ServiceRouteDestination `json:",omitempty"`
+}
+
+type ServiceRouteMatch struct {
+	HTTP *ServiceRouteHTTPMatch `json:",omitempty"`
+}
+
+type ServiceRouteHTTPMatch struct {
+	PathExact  string                        `json:",omitempty"`
+	PathPrefix string                        `json:",omitempty"`
+	PathRegex  string                        `json:",omitempty"`
+	Header     []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+	Methods    []string                      `json:",omitempty"`
+}
+
+type ServiceRouteHTTPMatchHeader struct {
+	Name    string
+	Present bool   `json:",omitempty"`
+	Exact   string `json:",omitempty"`
+	Regex   string `json:",omitempty"`
+}
+
+type ServiceRouteDestination struct {
+	Service       string `json:",omitempty"`
+	ServiceSubset string `json:",omitempty"`
+	// a go duration string, e.g. 1.5s
+	RequestTimeout        string `json:",omitempty"`
+	NumRetries            uint32 `json:",omitempty"`
+	RetryOnConnectFailure bool   `json:",omitempty"`
+}
+
+type ServiceSplitterConfigEntry struct {
+	Kind   string
+	Name   string
+	Splits []ServiceSplit `json:",omitempty"`
+}
+
+func (e *ServiceSplitterConfigEntry) GetKind() string { return e.Kind }
+func (e *ServiceSplitterConfigEntry) GetName() string { return e.Name }
+
+type ServiceSplit struct {
+	// percentage of traffic, all splits must add up to 100
+	Weight        float32
+	Service       string `json:",omitempty"`
+	ServiceSubset string `json:",omitempty"`
+}
+
+type ServiceResolverConfigEntry struct {
+	Kind          string
+	Name          string
+	DefaultSubset string                           `json:",omitempty"`
+	Subsets       map[string]ServiceResolverSubset `json:",omitempty"`
+}
+
+func (e *ServiceResolverConfigEntry) GetKind() string { return e.Kind }
+func (e *ServiceResolverConfigEntry) GetName() string { return e.Name }
+
+type ServiceResolverSubset struct {
+	// a consul filter expression evaluated against service instances
+	Filter      string `json:",omitempty"`
+	OnlyPassing bool   `json:",omitempty"`
+}
+
+// configEntries reads and writes config entries owned by supergloo
+type configEntries struct {
+	client *api.Client
+	// the client config, after it's been defaulted by api.NewClient
+	config *api.Config
+}
+
+func newConfigEntries(config *api.Config) (*configEntries, error) {
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating consul client")
+	}
+	return &configEntries{client: client, config: config}, nil
+}
+
+func ownedKey(kind, name string) string {
+	return configEntriesKvPrefix + kind + "/" + name
+}
+
+// returns the last written entry for each owned kind/name
+func (c *configEntries) listOwned() (map[string][]byte, error) {
+	pairs, _, err := c.client.KV().List(configEntriesKvPrefix, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing owned config entries")
+	}
+	owned := make(map[string][]byte)
+	for _, pair := range pairs {
+		owned[pair.Key] = pair.Value
+	}
+	return owned, nil
+}
+
+func (c *configEntries) set(entry ConfigEntry) error {
+	if _, err := c.client.Raw().Write("/v1/config", entry, nil, nil); err != nil {
+		return errors.Wrapf(err, "writing %v %v", entry.GetKind(), entry.GetName())
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := c.client.KV().Put(&api.KVPair{Key: ownedKey(entry.GetKind(), entry.GetName()), Value: data}, nil); err != nil {
+		return errors.Wrapf(err, "marking %v %v as owned", entry.GetKind(), entry.GetName())
+	}
+	return nil
+}
+
+// the raw api has no way to send a DELETE, so do it ourselves
+func (c *configEntries) delete(key string) error {
+	kindAndName := strings.TrimPrefix(key, configEntriesKvPrefix)
+	req, err := http.NewRequest(http.MethodDelete,
+		fmt.Sprintf("%v://%v/v1/config/%v", c.config.Scheme, c.config.Address, kindAndName), nil)
+	if err != nil {
+		return err
+	}
+	if c.config.Token != "" {
+		req.Header.Set("X-Consul-Token", c.config.Token)
+	}
+	resp, err := c.config.HttpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "deleting %v", kindAndName)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		var body bytes.Buffer
+		body.ReadFrom(resp.Body)
+		return errors.Errorf("deleting %v: unexpected response code %v (%v)", kindAndName, resp.StatusCode, body.String())
+	}
+	if _, err := c.client.KV().Delete(key, nil); err != nil {
+		return errors.Wrapf(err, "removing ownership of %v", kindAndName)
+	}
+	return nil
+}
+
+// writes the desired entries and deletes the owned entries which are no longer desired
+func (c *configEntries) reconcile(desired []ConfigEntry) error {
+	owned, err := c.listOwned()
+	if err != nil {
+		return err
+	}
+	for _, entry := range desired {
+		key := ownedKey(entry.GetKind(), entry.GetName())
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		previous, ok := owned[key]
+		delete(owned, key)
+		if ok && bytes.Equal(previous, data) {
+			continue
+		}
+		if err := c.set(entry); err != nil {
+			return err
+		}
+	}
+	// routers reference splitters which reference resolvers,
+	// so consul requires them to be deleted in that order
+	for _, kind := range []string{ServiceRouter, ServiceSplitter, ServiceResolver} {
+		for key := range owned {
+			if !strings.HasPrefix(key, configEntriesKvPrefix+kind+"/") {
+				continue
+			}
+			if err := c.delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
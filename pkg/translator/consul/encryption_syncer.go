@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 
 	istio "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
 )
@@ -17,34 +19,39 @@ type ConsulSyncer struct {
 }
 
 func (c *ConsulSyncer) Sync(_ context.Context, snap *v1.TranslatorSnapshot) error {
+	var multiErr *multierror.Error
 	for _, mesh := range snap.Meshes.List() {
 		_, ok := mesh.MeshType.(*v1.Mesh_Consul)
 		if !ok {
 			// not our mesh, we don't care
 			continue
 		}
-		encryption := mesh.Encryption
-		if encryption == nil {
-			continue
-		}
-		encryptionSecret := encryption.Secret
-		if encryptionSecret == nil {
-			continue
-		}
-		secret, err := snap.Istiocerts.List().Find(encryptionSecret.Namespace, encryptionSecret.Name)
-		if err != nil {
-			return err
+		if err := c.syncMesh(mesh, snap); err != nil {
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
 		}
+	}
+	return multiErr.ErrorOrNil()
+}
 
-		port := c.LocalPort
-		if port <= 0 {
-			port = 8500
-		}
-		if err := syncSecret(secret, port); err != nil {
-			return err
-		}
+func (c *ConsulSyncer) syncMesh(mesh *v1.Mesh, snap *v1.TranslatorSnapshot) error {
+	encryption := mesh.Encryption
+	if encryption == nil {
+		return nil
 	}
-	return nil
+	encryptionSecret := encryption.Secret
+	if encryptionSecret == nil {
+		return nil
+	}
+	secret, err := snap.Istiocerts.List().Find(encryptionSecret.Namespace, encryptionSecret.Name)
+	if err != nil {
+		return err
+	}
+
+	port := c.LocalPort
+	if port <= 0 {
+		port = 8500
+	}
+	return syncSecret(secret, port)
 }
 
 func validateTlsSecret(secret *istio.IstioCacertsSecret) error {
@@ -121,7 +128,7 @@ func syncSecret(secret *istio.IstioCacertsSecret, port int) error {
 	// Need to verify the cert was generated with EC
 	var writeOpts api.WriteOptions
 	if _, err = client.Connect().CASetConfig(conf, &writeOpts); err != nil {
-		return errors.Errorf("Error updating consul root certificate %v.")
+		return errors.Errorf("Error updating consul root certificate %v", err)
 	}
 	return nil
 }
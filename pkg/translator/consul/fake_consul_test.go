@@ -0,0 +1,211 @@
+package consul_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// fakeConsul serves the subset of the consul http api used by the syncers
+type fakeConsul struct {
+	*httptest.Server
+
+	lock          sync.Mutex
+	configEntries map[string]map[string]interface{}
+	kv            map[string][]byte
+	intentions    map[string]*api.Intention
+	// number of config entry writes received
+	configWrites int
+	// number of intentions created, used for their ids
+	intentionIds int
+	// the X-Consul-Token headers received, keyed by api (config, kv or intentions)
+	tokens map[string]map[string]bool
+}
+
+func newFakeConsul() *fakeConsul {
+	f := &fakeConsul{
+		configEntries: make(map[string]map[string]interface{}),
+		kv:            make(map[string][]byte),
+		intentions:    make(map[string]*api.Intention),
+		tokens:        make(map[string]map[string]bool),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/config", f.handleConfig)
+	mux.HandleFunc("/v1/config/", f.handleConfig)
+	mux.HandleFunc("/v1/kv/", f.handleKv)
+	mux.HandleFunc("/v1/connect/intentions", f.handleIntentions)
+	mux.HandleFunc("/v1/connect/intentions/", f.handleIntentions)
+	f.Server = httptest.NewServer(mux)
+	return f
+}
+
+// config entry as it was last written, keyed by kind/name
+func (f *fakeConsul) ConfigEntry(kind, name string) map[string]interface{} {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.configEntries[kind+"/"+name]
+}
+
+func (f *fakeConsul) ConfigEntryKeys() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var keys []string
+	for k := range f.configEntries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// the distinct X-Consul-Token headers of the requests to the api, sorted.
+// an empty token is recorded for the requests without one
+func (f *fakeConsul) Tokens(api string) []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var tokens []string
+	for token := range f.tokens[api] {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+	return tokens
+}
+
+func (f *fakeConsul) recordToken(api string, r *http.Request) {
+	if f.tokens[api] == nil {
+		f.tokens[api] = make(map[string]bool)
+	}
+	f.tokens[api][r.Header.Get("X-Consul-Token")] = true
+}
+
+func (f *fakeConsul) ConfigWrites() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.configWrites
+}
+
+// adds an intention as if it was created by someone else
+func (f *fakeConsul) AddIntention(intention *api.Intention) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.addIntention(intention)
+}
+
+func (f *fakeConsul) addIntention(intention *api.Intention) string {
+	f.intentionIds++
+	intention.ID = strconv.Itoa(f.intentionIds)
+	f.intentions[intention.ID] = intention
+	return intention.ID
+}
+
+// intentions sorted by source and destination
+func (f *fakeConsul) Intentions() []*api.Intention {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var intentions []*api.Intention
+	for _, intention := range f.intentions {
+		intentions = append(intentions, intention)
+	}
+	sort.Slice(intentions, func(i, j int) bool {
+		if intentions[i].SourceName != intentions[j].SourceName {
+			return intentions[i].SourceName < intentions[j].SourceName
+		}
+		return intentions[i].DestinationName < intentions[j].DestinationName
+	})
+	return intentions
+}
+
+func (f *fakeConsul) handleConfig(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.recordToken("config", r)
+	switch r.Method {
+	case http.MethodPut:
+		var entry map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.configEntries[entry["Kind"].(string)+"/"+entry["Name"].(string)] = entry
+		f.configWrites++
+		w.Write([]byte("true"))
+	case http.MethodDelete:
+		key := strings.TrimPrefix(r.URL.Path, "/v1/config/")
+		delete(f.configEntries, key)
+	default:
+		http.Error(w, "unsupported", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeConsul) handleKv(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.recordToken("kv", r)
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	switch r.Method {
+	case http.MethodGet:
+		var pairs api.KVPairs
+		for k, v := range f.kv {
+			if k == key || hasParam(r, "recurse") && strings.HasPrefix(k, key) {
+				pairs = append(pairs, &api.KVPair{Key: k, Value: v})
+			}
+		}
+		if len(pairs) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(pairs)
+	case http.MethodPut:
+		data, _ := ioutil.ReadAll(r.Body)
+		f.kv[key] = data
+		w.Write([]byte("true"))
+	case http.MethodDelete:
+		delete(f.kv, key)
+		w.Write([]byte("true"))
+	default:
+		http.Error(w, "unsupported", http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeConsul) handleIntentions(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.recordToken("intentions", r)
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/v1/connect/intentions"), "/")
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		intentions := []*api.Intention{}
+		for _, intention := range f.intentions {
+			intentions = append(intentions, intention)
+		}
+		json.NewEncoder(w).Encode(intentions)
+	case r.Method == http.MethodPost && id == "":
+		var intention api.Intention
+		if err := json.NewDecoder(r.Body).Decode(&intention); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, existing := range f.intentions {
+			if existing.SourceName == intention.SourceName && existing.DestinationName == intention.DestinationName {
+				http.Error(w, "duplicate intention found", http.StatusInternalServerError)
+				return
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]string{"ID": f.addIntention(&intention)})
+	case r.Method == http.MethodDelete && id != "":
+		delete(f.intentions, id)
+	default:
+		http.Error(w, "unsupported", http.StatusMethodNotAllowed)
+	}
+}
+
+func hasParam(r *http.Request, name string) bool {
+	_, ok := r.URL.Query()[name]
+	return ok
+}
@@ -0,0 +1,53 @@
+package consul_test
+
+import (
+	kubecore "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// in-memory stand-in for the kube clientset, serving the acl token secrets read by the syncers.
+// the other methods of the clientset are left unimplemented
+type fakeKube struct {
+	kubeclient.Interface
+	secrets []kubecore.Secret
+}
+
+func (k *fakeKube) CoreV1() corev1.CoreV1Interface {
+	return &fakeCoreV1{kube: k}
+}
+
+type fakeCoreV1 struct {
+	corev1.CoreV1Interface
+	kube *fakeKube
+}
+
+func (c *fakeCoreV1) Secrets(namespace string) corev1.SecretInterface {
+	return &fakeSecrets{kube: c.kube, namespace: namespace}
+}
+
+type fakeSecrets struct {
+	corev1.SecretInterface
+	kube      *fakeKube
+	namespace string
+}
+
+func (s *fakeSecrets) Get(name string, options kubemeta.GetOptions) (*kubecore.Secret, error) {
+	for _, secret := range s.kube.secrets {
+		if secret.Namespace == s.namespace && secret.Name == name {
+			return secret.DeepCopy(), nil
+		}
+	}
+	return nil, kubeerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+}
+
+// kube clientset holding the acl token secret supergloo-system.consul-token
+func fakeKubeWithAclToken(token string) *fakeKube {
+	return &fakeKube{secrets: []kubecore.Secret{{
+		ObjectMeta: kubemeta.ObjectMeta{Name: "consul-token", Namespace: "supergloo-system"},
+		Data:       map[string][]byte{"token": []byte(token)},
+	}}}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/go-multierror"
@@ -11,35 +13,45 @@ import (
 	"github.com/solo-io/solo-kit/pkg/utils/contextutils"
 	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
 	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/consul"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 )
 
 const (
 	metadataName = "supergloo.name"
+	// the mesh whose policy the intention was created for. the intentions created before it was
+	// added only have a name, they are owned by any consul mesh
+	metadataMesh = "supergloo.mesh"
 )
 
+// PolicySyncer reconciles the intentions of each consul mesh with its policy.
+// the intentions supergloo created for the mesh are removed along with its policy
 type PolicySyncer struct {
+	// reads the acl token secrets of the meshes
+	Kube kubernetes.Interface
 }
 
 func (s *PolicySyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
 	ctx = contextutils.WithLogger(ctx, "consul-policy-syncer")
 
+	var multiErr *multierror.Error
 	for _, mesh := range snap.Meshes.List() {
-		_, ok := mesh.MeshType.(*v1.Mesh_Consul)
-		if !ok {
+		consulMesh, ok := mesh.MeshType.(*v1.Mesh_Consul)
+		if !ok || consulMesh.Consul == nil {
 			// not our mesh, we don't care
 			continue
 		}
 
-		policy := mesh.Policy
-		if policy != nil {
-			if err := s.syncPolicy(ctx, snap.Upstreams, policy); err != nil {
-				return err
-			}
+		// the rules of the mesh policies targeting the mesh are enforced along with its own.
+		// without any, our intentions are all removed
+		policy := shared.MergedPolicy(mesh, snap.Meshpolicies.List(), snap.Upstreams.List())
+		if err := s.syncPolicy(ctx, consulMesh.Consul, mesh.Metadata.Ref(), snap.Upstreams, policy); err != nil {
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
 		}
 	}
-	return nil
+	return multiErr.ErrorOrNil()
 }
 
 func get(upstreams gloov1.UpstreamsByNamespace, ref core.ResourceRef) (*consul.UpstreamSpec, error) {
@@ -54,49 +66,37 @@ func get(upstreams gloov1.UpstreamsByNamespace, ref core.ResourceRef) (*consul.U
 	return spec.Consul, nil
 }
 
-func (s *PolicySyncer) syncPolicy(ctx context.Context, upstreams gloov1.UpstreamsByNamespace, p *v1.Policy) error {
+func (s *PolicySyncer) syncPolicy(ctx context.Context, mesh *v1.Consul, meshRef core.ResourceRef, upstreams gloov1.UpstreamsByNamespace, p *v1.Policy) error {
 	logger := contextutils.LoggerFrom(ctx)
 
-	var client *api.Client
-	var err error
-	client, err = api.NewClient(api.DefaultConfig())
-
+	config, err := consulConfig(s.Kube, mesh)
+	if err != nil {
+		return err
+	}
+	client, err := api.NewClient(config)
 	if err != nil {
 		return err
 	}
 	connectClient := client.Connect()
 
-	// create desired intentions
+	// the rules which cannot be expressed are reported once the others are applied
+	var unsupported *multierror.Error
 	var desiredIntentions []*api.Intention
-	for _, rule := range p.Rules {
-		if rule.Source == nil {
-			// TODO: should we return error instead?
-			continue
-		}
-		if rule.Destination == nil {
-			// TODO: should we return error instead?
-			continue
-		}
-
-		consuleSource, err := get(upstreams, *rule.Source)
-		if err != nil {
-			return err
-		}
-
-		consuleDestination, err := get(upstreams, *rule.Destination)
+	switch {
+	case p == nil || p.Mode == v1.Policy_OFF:
+		// our intentions are all removed
+	case p.Mode == v1.Policy_PERMISSIVE:
+		unsupported = multierror.Append(unsupported, shared.UnsupportedPolicyError("permissive policies", "consul intentions"))
+	default:
+		desiredIntentions, unsupported, err = intentionsForPolicy(upstreams, p)
 		if err != nil {
 			return err
 		}
-
-		// need to convert our upstream to a consul service?
-		name := fmt.Sprintf("consuleSource.ServiceName-consuleDestination.ServiceName")
-		desiredIntentions = append(desiredIntentions, &api.Intention{
-			Action:          api.IntentionActionAllow,
-			Meta:            map[string]string{metadataName: name},
-			SourceName:      consuleSource.ServiceName,
-			DestinationName: consuleDestination.ServiceName,
-		})
 	}
+	for _, intention := range desiredIntentions {
+		intention.Meta[metadataMesh] = meshRef.Key()
+	}
+
 	// create an intention and hope for the best!
 	// get all intentions
 	intentions, _, err := connectClient.Intentions(nil)
@@ -109,15 +109,13 @@ func (s *PolicySyncer) syncPolicy(ctx context.Context, upstreams gloov1.Upstream
 	var removeThese []*api.Intention
 Outloop:
 	for _, intention := range intentions {
-		if intention.Meta == nil {
-			continue
-		}
-		if len(intention.Meta[metadataName]) == 0 {
+		if !ownedBy(intention, meshRef) {
 			continue
 		}
-		// this intention is own by us, let's see if it's still needed
+		// this intention is own by us, let's see if it's still needed.
+		// the ones created without the mesh are replaced
 		for i, desiredIntention := range desiredIntentions {
-			if intention.Meta[metadataName] == desiredIntention.Meta[metadataName] {
+			if reflect.DeepEqual(intention.Meta, desiredIntention.Meta) && intention.Action == desiredIntention.Action {
 				// this is desired exists. remove it from desired as we don't need to ad it.
 				desiredIntentions = append(desiredIntentions[:i], desiredIntentions[i+1:]...)
 				continue Outloop
@@ -129,7 +127,15 @@ Outloop:
 
 	logger.Infow("Adding intentions", "toadd", desiredIntentions, "toremove", removeThese)
 
-	var multiErr *multierror.Error
+	multiErr := unsupported
+	// removed first, as there can only be one intention from a source to a destination
+	for _, intention := range removeThese {
+		_, err := connectClient.IntentionDelete(intention.ID, nil)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
 	for _, intention := range desiredIntentions {
 		_, _, err := connectClient.IntentionCreate(intention, nil)
 		if err != nil {
@@ -139,12 +145,106 @@ Outloop:
 
 	}
 
-	for _, intention := range removeThese {
-		_, err := connectClient.IntentionDelete(intention.ID, nil)
+	return multiErr.ErrorOrNil()
+}
+
+// whether supergloo created the intention for the policy of the mesh
+func ownedBy(intention *api.Intention, meshRef core.ResourceRef) bool {
+	if len(intention.Meta[metadataName]) == 0 {
+		return false
+	}
+	mesh, ok := intention.Meta[metadataMesh]
+	return !ok || mesh == meshRef.Key()
+}
+
+// returns an intention for each rule of the policy. the deny rules take precedence over the rules which allow
+// the connections from the same source to the same destination. intentions cannot restrict the requests
+// further, so the rules which do are left out and returned as unsupported, except for the deny rules, which deny
+// all the connections instead. the rules to the destinations the policy is not enforced for are left out,
+// the deny rules among them are returned as unsupported
+func intentionsForPolicy(upstreams gloov1.UpstreamsByNamespace, p *v1.Policy) ([]*api.Intention, *multierror.Error, error) {
+	var intentions []*api.Intention
+	var unsupported *multierror.Error
+	enforced := func(destination core.ResourceRef) (string, bool) {
+		namespace, ok := shared.DestinationNamespace(upstreams.List(), destination)
+		return namespace, !ok || shared.PolicyEnforced(p, namespace)
+	}
+	denied := map[string]bool{}
+	for _, rule := range p.DenyRules {
+		if rule.Source == nil || rule.Destination == nil {
+			continue
+		}
+		if namespace, ok := enforced(*rule.Destination); !ok {
+			unsupported = multierror.Append(unsupported, fmt.Errorf("deny rule from %v to %v cannot be applied: "+
+				"the policy is not enforced for the services in namespace %v", rule.Source.Key(), rule.Destination.Key(), namespace))
+			continue
+		}
+		if restrictions := shared.RuleRestrictions(rule); len(restrictions) > 0 {
+			unsupported = multierror.Append(unsupported, fmt.Errorf("deny rule from %v to %v denies all the connections "+
+				"from its source to its destination: %v are not supported by consul intentions",
+				rule.Source.Key(), rule.Destination.Key(), strings.Join(restrictions, ", ")))
+		}
+		intention, err := intentionForRule(upstreams, rule, api.IntentionActionDeny)
 		if err != nil {
-			multiErr = multierror.Append(multiErr, err)
+			return nil, nil, err
+		}
+		if denied[intention.Meta[metadataName]] {
+			continue
 		}
+		denied[intention.Meta[metadataName]] = true
+		intentions = append(intentions, intention)
 	}
 
-	return multiErr.ErrorOrNil()
+	allowed := map[string]bool{}
+	for _, rule := range p.Rules {
+		if rule.Source == nil {
+			// TODO: should we return error instead?
+			continue
+		}
+		if rule.Destination == nil {
+			// TODO: should we return error instead?
+			continue
+		}
+		if _, ok := enforced(*rule.Destination); !ok {
+			// the connections to the destination are not restricted by the policy
+			continue
+		}
+		// intentions allow or deny all the connections from the source to the destination
+		if len(shared.RuleRestrictions(rule)) > 0 {
+			unsupported = multierror.Append(unsupported, shared.UnsupportedRestrictionsError(rule, "consul intentions"))
+			continue
+		}
+		intention, err := intentionForRule(upstreams, rule, api.IntentionActionAllow)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := intention.Meta[metadataName]
+		if denied[name] || allowed[name] {
+			continue
+		}
+		allowed[name] = true
+		intentions = append(intentions, intention)
+	}
+	return intentions, unsupported, nil
+}
+
+func intentionForRule(upstreams gloov1.UpstreamsByNamespace, rule *v1.Rule, action api.IntentionAction) (*api.Intention, error) {
+	consuleSource, err := get(upstreams, *rule.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	consuleDestination, err := get(upstreams, *rule.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	// the intentions are identified by their source and destination services
+	name := fmt.Sprintf("%v-%v", consuleSource.ServiceName, consuleDestination.ServiceName)
+	return &api.Intention{
+		Action:          action,
+		Meta:            map[string]string{metadataName: name},
+		SourceName:      consuleSource.ServiceName,
+		DestinationName: consuleDestination.ServiceName,
+	}, nil
 }
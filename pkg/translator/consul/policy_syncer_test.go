@@ -0,0 +1,199 @@
+package consul_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	consulplugin "github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/consul"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/consul"
+)
+
+var _ = Describe("PolicySyncer", func() {
+	var (
+		fake *fakeConsul
+		s    *PolicySyncer
+	)
+	upstream := func(name string) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Consul{
+					Consul: &consulplugin.UpstreamSpec{ServiceName: name},
+				},
+			},
+		}
+	}
+	ref := func(name string) *core.ResourceRef {
+		return &core.ResourceRef{Name: name, Namespace: "gloo-system"}
+	}
+	snapshot := func(policy *v1.Policy) *v1.TranslatorSnapshot {
+		return &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "consul", Namespace: "supergloo-system"},
+					MeshType: &v1.Mesh_Consul{
+						Consul: &v1.Consul{
+							ServerAddress: strings.TrimPrefix(fake.URL, "http://"),
+						},
+					},
+					Policy: policy,
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"gloo-system": {upstream("productpage"), upstream("reviews"), upstream("ratings")},
+			},
+		}
+	}
+	// the action of each intention, keyed by source and destination
+	actions := func() map[string]api.IntentionAction {
+		actions := map[string]api.IntentionAction{}
+		for _, intention := range fake.Intentions() {
+			actions[intention.SourceName+"->"+intention.DestinationName] = intention.Action
+		}
+		return actions
+	}
+
+	BeforeEach(func() {
+		fake = newFakeConsul()
+		s = &PolicySyncer{}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("creates the intentions of the policy on the server of the mesh", func() {
+		err := s.Sync(context.TODO(), snapshot(&v1.Policy{
+			Rules: []*v1.Rule{
+				{Source: ref("productpage"), Destination: ref("reviews")},
+				{Source: ref("reviews"), Destination: ref("ratings")},
+			},
+			DenyRules: []*v1.Rule{
+				{Source: ref("productpage"), Destination: ref("ratings")},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions()).To(Equal(map[string]api.IntentionAction{
+			"productpage->reviews": api.IntentionActionAllow,
+			"productpage->ratings": api.IntentionActionDeny,
+			"reviews->ratings":     api.IntentionActionAllow,
+		}))
+		for _, intention := range fake.Intentions() {
+			Expect(intention.Meta).To(HaveKeyWithValue("supergloo.mesh", "supergloo-system.consul"))
+		}
+
+		// nothing changes on the next sync
+		err = s.Sync(context.TODO(), snapshot(&v1.Policy{
+			Rules: []*v1.Rule{
+				{Source: ref("productpage"), Destination: ref("reviews")},
+				{Source: ref("reviews"), Destination: ref("ratings")},
+			},
+			DenyRules: []*v1.Rule{
+				{Source: ref("productpage"), Destination: ref("ratings")},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.Intentions()).To(HaveLen(3))
+		Expect(fake.intentionIds).To(Equal(3))
+	})
+
+	It("removes the intentions it created once the policy is removed", func() {
+		fake.AddIntention(&api.Intention{
+			SourceName:      "web",
+			DestinationName: "db",
+			Action:          api.IntentionActionAllow,
+		})
+		// created by an older supergloo, without the mesh
+		fake.AddIntention(&api.Intention{
+			SourceName:      "reviews",
+			DestinationName: "ratings",
+			Action:          api.IntentionActionAllow,
+			Meta:            map[string]string{"supergloo.name": "reviews-ratings"},
+		})
+		// created for another mesh
+		fake.AddIntention(&api.Intention{
+			SourceName:      "details",
+			DestinationName: "ratings",
+			Action:          api.IntentionActionAllow,
+			Meta:            map[string]string{"supergloo.name": "details-ratings", "supergloo.mesh": "default.consul"},
+		})
+
+		err := s.Sync(context.TODO(), snapshot(&v1.Policy{
+			Rules: []*v1.Rule{{Source: ref("productpage"), Destination: ref("reviews")}},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions()).To(Equal(map[string]api.IntentionAction{
+			"details->ratings":     api.IntentionActionAllow,
+			"productpage->reviews": api.IntentionActionAllow,
+			"web->db":              api.IntentionActionAllow,
+		}))
+
+		err = s.Sync(context.TODO(), snapshot(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions()).To(Equal(map[string]api.IntentionAction{
+			"details->ratings": api.IntentionActionAllow,
+			"web->db":          api.IntentionActionAllow,
+		}))
+	})
+
+	It("creates the intentions of the mesh policies only for the upstreams in their namespace", func() {
+		snap := snapshot(nil)
+		db := upstream("db")
+		db.Metadata.Namespace = "team-a"
+		snap.Upstreams["team-a"] = gloov1.UpstreamList{db}
+		dbRef := &core.ResourceRef{Name: "db", Namespace: "team-a"}
+		snap.Meshpolicies = v1.MeshPolicyList{{
+			Metadata:   core.Metadata{Name: "team-a", Namespace: "team-a"},
+			TargetMesh: &core.ResourceRef{Name: "consul", Namespace: "supergloo-system"},
+			Rules: []*v1.Rule{
+				{Source: ref("productpage"), Destination: dbRef},
+				// another namespace, rejected on the status of the mesh policy
+				{Source: ref("productpage"), Destination: ref("reviews")},
+			},
+		}}.ByNamespace()
+		err := s.Sync(context.TODO(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions()).To(Equal(map[string]api.IntentionAction{
+			"productpage->db": api.IntentionActionAllow,
+		}))
+	})
+
+	It("leaves out the rules to the upstreams of the namespaces the policy is not enforced for", func() {
+		err := s.Sync(context.TODO(), snapshot(&v1.Policy{
+			Namespaces: []string{"team-a"},
+			Rules:      []*v1.Rule{{Source: ref("productpage"), Destination: ref("reviews")}},
+			DenyRules:  []*v1.Rule{{Source: ref("productpage"), Destination: ref("ratings")}},
+		}))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("deny rule from gloo-system.productpage to gloo-system.ratings cannot be applied: " +
+			"the policy is not enforced for the services in namespace gloo-system"))
+		Expect(fake.Intentions()).To(BeEmpty())
+	})
+
+	It("reports the acl token secret it cannot read", func() {
+		snap := snapshot(nil)
+		snap.Meshes[""][0].GetConsul().AclTokenSecret = &core.ResourceRef{Name: "consul-token", Namespace: "supergloo-system"}
+		err := s.Sync(context.TODO(), snap)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("acl token secret supergloo-system.consul-token"))
+	})
+
+	It("authenticates with the acl token of the mesh", func() {
+		s.Kube = fakeKubeWithAclToken("consul-acl-token")
+		snap := snapshot(&v1.Policy{
+			Rules: []*v1.Rule{{Source: ref("productpage"), Destination: ref("reviews")}},
+		})
+		snap.Meshes[""][0].GetConsul().AclTokenSecret = &core.ResourceRef{Name: "consul-token", Namespace: "supergloo-system"}
+		err := s.Sync(context.TODO(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions()).To(Equal(map[string]api.IntentionAction{
+			"productpage->reviews": api.IntentionActionAllow,
+		}))
+		Expect(fake.Tokens("intentions")).To(Equal([]string{"consul-acl-token"}))
+	})
+})
@@ -0,0 +1,404 @@
+package consul
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/contextutils"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
+)
+
+// RoutingSyncer translates routing rules which target a consul mesh into
+// service-router, service-splitter and service-resolver config entries.
+// consul only accepts these for services configured with the http protocol
+type RoutingSyncer struct {
+	// reads the acl token secrets of the meshes
+	Kube kubernetes.Interface
+}
+
+func (s *RoutingSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "consul-routing-syncer")
+	rules := snap.Routingrules.List()
+	upstreams := snap.Upstreams.List()
+
+	var multiErr *multierror.Error
+	for _, mesh := range snap.Meshes.List() {
+		consulMesh, ok := mesh.MeshType.(*v1.Mesh_Consul)
+		if !ok || consulMesh.Consul == nil {
+			// not our mesh, we don't care
+			continue
+		}
+		var meshRules v1.RoutingRuleList
+		for _, rule := range shared.RulesForMesh(rules, mesh) {
+			expanded, err := shared.ExpandUpstreamSelectors(rule, upstreams)
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Warnf("skipping routing rule %v: %v", rule.Metadata.Ref(), err)
+				continue
+			}
+			meshRules = append(meshRules, expanded)
+		}
+		if err := s.syncMesh(ctx, consulMesh.Consul, meshRules, upstreams); err != nil {
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func (s *RoutingSyncer) syncMesh(ctx context.Context, mesh *v1.Consul, rules v1.RoutingRuleList, upstreams gloov1.UpstreamList) error {
+	logger := contextutils.LoggerFrom(ctx)
+	entries, err := configEntriesForRules(rules, upstreams)
+	if err != nil {
+		return err
+	}
+	config, err := consulConfig(s.Kube, mesh)
+	if err != nil {
+		return err
+	}
+	client, err := newConfigEntries(config)
+	if err != nil {
+		return err
+	}
+	logger.Infof("reconciling %v config entries", len(entries))
+	return client.reconcile(entries)
+}
+
+// a consul service, optionally narrowed to a subset of its instances
+type consulDestination struct {
+	service string
+	subset  string
+	filter  string
+}
+
+func destinationForUpstream(us *gloov1.Upstream) (*consulDestination, error) {
+	if us.UpstreamSpec != nil {
+		switch spec := us.UpstreamSpec.UpstreamType.(type) {
+		case *gloov1.UpstreamSpec_Consul:
+			dest := &consulDestination{service: spec.Consul.ServiceName}
+			if len(spec.Consul.ServiceTags) > 0 {
+				tags := append([]string{}, spec.Consul.ServiceTags...)
+				sort.Strings(tags)
+				var filters []string
+				for _, tag := range tags {
+					filters = append(filters, `"`+tag+`" in Service.Tags`)
+				}
+				dest.subset = strings.ToLower(strings.Join(tags, "-"))
+				dest.filter = strings.Join(filters, " and ")
+			}
+			return dest, nil
+		case *gloov1.UpstreamSpec_Kube:
+			// consul-k8s registers services under their kubernetes name
+			return &consulDestination{service: spec.Kube.ServiceName}, nil
+		}
+	}
+	return nil, errors.Errorf("upstream %v is not a consul or kubernetes upstream", us.Metadata.Ref())
+}
+
+// returns the services selected by the rule's destinations, one per unique service
+func servicesForRule(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]string, error) {
+	var destinations []*consulDestination
+	if len(rule.Destinations) == 0 {
+		// every upstream consul knows about is a valid destination
+		for _, us := range upstreams {
+			if dest, err := destinationForUpstream(us); err == nil {
+				destinations = append(destinations, dest)
+			}
+		}
+	}
+	for _, ref := range rule.Destinations {
+		us, err := upstreams.Find(ref.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination for rule %v", ref)
+		}
+		dest, err := destinationForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination for rule %v", rule.Metadata.Ref())
+		}
+		destinations = append(destinations, dest)
+	}
+	var services []string
+addUniqueServices:
+	for _, dest := range destinations {
+		for _, added := range services {
+			if added == dest.service {
+				continue addUniqueServices
+			}
+		}
+		services = append(services, dest.service)
+	}
+	return services, nil
+}
+
+type configEntriesBuilder struct {
+	resolvers map[string]*ServiceResolverConfigEntry
+	splitters map[string]*ServiceSplitterConfigEntry
+	routers   map[string]*ServiceRouterConfigEntry
+}
+
+func configEntriesForRules(rules v1.RoutingRuleList, upstreams gloov1.UpstreamList) ([]ConfigEntry, error) {
+	b := &configEntriesBuilder{
+		resolvers: make(map[string]*ServiceResolverConfigEntry),
+		splitters: make(map[string]*ServiceSplitterConfigEntry),
+		routers:   make(map[string]*ServiceRouterConfigEntry),
+	}
+	for _, rule := range rules {
+		if err := b.addRule(rule, upstreams); err != nil {
+			return nil, errors.Wrapf(err, "rule %v", rule.Metadata.Ref())
+		}
+	}
+	return b.entries(), nil
+}
+
+// entries are ordered so that every entry is written after the entries it references
+func (b *configEntriesBuilder) entries() []ConfigEntry {
+	var entries []ConfigEntry
+	for _, name := range sortedKeys(b.resolvers) {
+		entries = append(entries, b.resolvers[name])
+	}
+	for _, name := range sortedKeys(b.splitters) {
+		entries = append(entries, b.splitters[name])
+	}
+	for _, name := range sortedKeys(b.routers) {
+		router := b.routers[name]
+		// consul uses the first matching route, so catch-all routes go last
+		sort.SliceStable(router.Routes, func(i, j int) bool {
+			return router.Routes[i].Match != nil && router.Routes[j].Match == nil
+		})
+		entries = append(entries, router)
+	}
+	return entries
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// registers the subset of the destination with the service's resolver
+func (b *configEntriesBuilder) addSubset(dest *consulDestination) error {
+	if dest.subset == "" {
+		return nil
+	}
+	resolver, ok := b.resolvers[dest.service]
+	if !ok {
+		resolver = &ServiceResolverConfigEntry{
+			Kind:    ServiceResolver,
+			Name:    dest.service,
+			Subsets: make(map[string]ServiceResolverSubset),
+		}
+		b.resolvers[dest.service] = resolver
+	}
+	if existing, ok := resolver.Subsets[dest.subset]; ok && existing.Filter != dest.filter {
+		return errors.Errorf("subset %v of service %v redefined", dest.subset, dest.service)
+	}
+	resolver.Subsets[dest.subset] = ServiceResolverSubset{Filter: dest.filter}
+	return nil
+}
+
+func (b *configEntriesBuilder) addRule(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) error {
+	if rule.TcpRouting != nil || rule.TlsRouting != nil {
+		return errors.Errorf("tcp and tls routing are not supported by consul, its config entries only route http")
+	}
+	if rule.TrafficPolicy != nil {
+		return errors.Errorf("traffic policies are not supported by consul")
+	}
+	services, err := servicesForRule(rule, upstreams)
+	if err != nil {
+		return err
+	}
+
+	var weighted []*consulDestination
+	var weights []uint32
+	if rule.TrafficShifting != nil {
+		for _, dest := range rule.TrafficShifting.Destinations {
+			if dest.Upstream == nil {
+				return errors.Errorf("weighted destination must specify an upstream")
+			}
+			us, err := upstreams.Find(dest.Upstream.Strings())
+			if err != nil {
+				return errors.Wrapf(err, "invalid destination %v", dest)
+			}
+			consulDest, err := destinationForUpstream(us)
+			if err != nil {
+				return errors.Wrapf(err, "invalid destination %v", dest)
+			}
+			if err := b.addSubset(consulDest); err != nil {
+				return err
+			}
+			weighted = append(weighted, consulDest)
+			weights = append(weights, dest.Weight)
+		}
+	}
+
+	var routeDestination *consulDestination
+	switch {
+	case len(weighted) > 1 && len(rule.RequestMatchers) > 0:
+		return errors.Errorf("consul can only split traffic across multiple destinations for all requests to a service, " +
+			"remove the request matchers or shift traffic to a single destination")
+	case len(weighted) > 1:
+		splits, err := splitsForDestinations(weighted, weights)
+		if err != nil {
+			return err
+		}
+		for _, svc := range services {
+			if _, ok := b.splitters[svc]; ok {
+				return errors.Errorf("TrafficShifting redefined for service %v", svc)
+			}
+			b.splitters[svc] = &ServiceSplitterConfigEntry{
+				Kind:   ServiceSplitter,
+				Name:   svc,
+				Splits: splits,
+			}
+		}
+	case len(weighted) == 1:
+		// a single destination is expressed as a route instead of a split
+		routeDestination = weighted[0]
+	}
+
+	if routeDestination == nil && rule.Timeout == nil && rule.Retries == nil {
+		// nothing to route
+		return nil
+	}
+	var timeout string
+	if rule.Timeout != nil {
+		duration, err := types.DurationFromProto(rule.Timeout)
+		if err != nil {
+			return errors.Wrapf(err, "invalid timeout")
+		}
+		timeout = duration.String()
+	}
+	var numRetries uint32
+	if rule.Retries != nil && rule.Retries.Attempts > 0 {
+		numRetries = uint32(rule.Retries.Attempts)
+	}
+
+	matches := []*ServiceRouteMatch{nil}
+	if len(rule.RequestMatchers) > 0 {
+		matches = nil
+		for _, matcher := range rule.RequestMatchers {
+			matches = append(matches, convertMatcher(matcher))
+		}
+	}
+	for _, svc := range services {
+		for _, match := range matches {
+			destination := &ServiceRouteDestination{
+				Service:        svc,
+				RequestTimeout: timeout,
+				NumRetries:     numRetries,
+			}
+			if routeDestination != nil {
+				destination.Service = routeDestination.service
+				destination.ServiceSubset = routeDestination.subset
+			}
+			if err := b.addRoute(svc, ServiceRoute{Match: match, Destination: destination}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// routes with the same match are merged into one, as consul only applies the first matching route
+func (b *configEntriesBuilder) addRoute(service string, route ServiceRoute) error {
+	router, ok := b.routers[service]
+	if !ok {
+		router = &ServiceRouterConfigEntry{
+			Kind: ServiceRouter,
+			Name: service,
+		}
+		b.routers[service] = router
+	}
+	for _, existing := range router.Routes {
+		if !reflect.DeepEqual(existing.Match, route.Match) {
+			continue
+		}
+		dest, add := existing.Destination, route.Destination
+		if add.Service != service || add.ServiceSubset != "" {
+			if dest.Service != service || dest.ServiceSubset != "" {
+				return errors.Errorf("TrafficShifting redefined for service %v", service)
+			}
+			dest.Service, dest.ServiceSubset = add.Service, add.ServiceSubset
+		}
+		if add.RequestTimeout != "" {
+			if dest.RequestTimeout != "" {
+				return errors.Errorf("Timeout redefined for service %v", service)
+			}
+			dest.RequestTimeout = add.RequestTimeout
+		}
+		if add.NumRetries > 0 {
+			if dest.NumRetries > 0 {
+				return errors.Errorf("Retries redefined for service %v", service)
+			}
+			dest.NumRetries = add.NumRetries
+		}
+		return nil
+	}
+	router.Routes = append(router.Routes, route)
+	return nil
+}
+
+// consul requires split weights to be percentages adding up to exactly 100
+func splitsForDestinations(destinations []*consulDestination, weights []uint32) ([]ServiceSplit, error) {
+	var total uint32
+	for _, w := range weights {
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.Errorf("traffic shifting weights must add up to more than 0")
+	}
+	var splits []ServiceSplit
+	var assigned float64
+	for i, dest := range destinations {
+		percent := math.Round(float64(weights[i])*10000/float64(total)) / 100
+		if i == len(destinations)-1 {
+			percent = math.Round((100-assigned)*100) / 100
+		}
+		assigned += percent
+		splits = append(splits, ServiceSplit{
+			Weight:        float32(percent),
+			Service:       dest.service,
+			ServiceSubset: dest.subset,
+		})
+	}
+	return splits, nil
+}
+
+func convertMatcher(match *gloov1.Matcher) *ServiceRouteMatch {
+	http := &ServiceRouteHTTPMatch{
+		Methods: match.Methods,
+	}
+	switch path := match.PathSpecifier.(type) {
+	case *gloov1.Matcher_Exact:
+		http.PathExact = path.Exact
+	case *gloov1.Matcher_Regex:
+		http.PathRegex = path.Regex
+	case *gloov1.Matcher_Prefix:
+		http.PathPrefix = path.Prefix
+	}
+	for _, header := range match.Headers {
+		h := ServiceRouteHTTPMatchHeader{Name: header.Name}
+		switch {
+		case header.Value == "":
+			h.Present = true
+		case header.Regex:
+			h.Regex = header.Value
+		default:
+			h.Exact = header.Value
+		}
+		http.Header = append(http.Header, h)
+	}
+	return &ServiceRouteMatch{HTTP: http}
+}
@@ -0,0 +1,200 @@
+package consul_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	consulplugin "github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/consul"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/consul"
+)
+
+var _ = Describe("RoutingSyncer", func() {
+	var (
+		fake *fakeConsul
+		s    *RoutingSyncer
+	)
+	upstream := func(name, service string, tags ...string) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Consul{
+					Consul: &consulplugin.UpstreamSpec{
+						ServiceName: service,
+						ServiceTags: tags,
+					},
+				},
+			},
+		}
+	}
+	snapshot := func(rules ...*v1.RoutingRule) *v1.TranslatorSnapshot {
+		return &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "consul", Namespace: "supergloo-system"},
+					MeshType: &v1.Mesh_Consul{
+						Consul: &v1.Consul{
+							ServerAddress: strings.TrimPrefix(fake.URL, "http://"),
+						},
+					},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {
+					upstream("reviews", "reviews"),
+					upstream("reviews-v1", "reviews", "v1"),
+					upstream("reviews-v2", "reviews", "v2"),
+				},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{
+				"": rules,
+			},
+		}
+	}
+	consulMesh := &core.ResourceRef{Name: "consul", Namespace: "supergloo-system"}
+	ref := func(name string) *core.ResourceRef {
+		return &core.ResourceRef{Name: name, Namespace: "gloo-system"}
+	}
+
+	BeforeEach(func() {
+		fake = newFakeConsul()
+		s = &RoutingSyncer{}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("writes routers, splitters and resolvers for consul rules", func() {
+		err := s.Sync(context.TODO(), snapshot(
+			&v1.RoutingRule{
+				Metadata:     core.Metadata{Name: "trafficshifting", Namespace: "default"},
+				TargetMesh:   consulMesh,
+				Destinations: []*core.ResourceRef{ref("reviews")},
+				TrafficShifting: &v1.TrafficShifting{
+					Destinations: []*v1.WeightedDestination{
+						{Upstream: ref("reviews-v1"), Weight: 1},
+						{Upstream: ref("reviews-v2"), Weight: 2},
+					},
+				},
+			},
+			&v1.RoutingRule{
+				Metadata:     core.Metadata{Name: "timeout", Namespace: "default"},
+				TargetMesh:   consulMesh,
+				Destinations: []*core.ResourceRef{ref("reviews")},
+				RequestMatchers: []*gloov1.Matcher{{
+					PathSpecifier: &gloov1.Matcher_Prefix{Prefix: "/api"},
+					Methods:       []string{"GET"},
+					Headers:       []*gloov1.HeaderMatcher{{Name: "x-debug"}},
+				}},
+				Timeout: types.DurationProto(time.Second * 3),
+			},
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fake.ConfigEntryKeys()).To(Equal([]string{
+			"service-resolver/reviews",
+			"service-router/reviews",
+			"service-splitter/reviews",
+		}))
+		Expect(fake.ConfigEntry(ServiceResolver, "reviews")).To(Equal(map[string]interface{}{
+			"Kind": "service-resolver",
+			"Name": "reviews",
+			"Subsets": map[string]interface{}{
+				"v1": map[string]interface{}{"Filter": `"v1" in Service.Tags`},
+				"v2": map[string]interface{}{"Filter": `"v2" in Service.Tags`},
+			},
+		}))
+		Expect(fake.ConfigEntry(ServiceSplitter, "reviews")).To(Equal(map[string]interface{}{
+			"Kind": "service-splitter",
+			"Name": "reviews",
+			"Splits": []interface{}{
+				map[string]interface{}{"Weight": 33.33, "Service": "reviews", "ServiceSubset": "v1"},
+				map[string]interface{}{"Weight": 66.67, "Service": "reviews", "ServiceSubset": "v2"},
+			},
+		}))
+		Expect(fake.ConfigEntry(ServiceRouter, "reviews")).To(Equal(map[string]interface{}{
+			"Kind": "service-router",
+			"Name": "reviews",
+			"Routes": []interface{}{
+				map[string]interface{}{
+					"Match": map[string]interface{}{
+						"HTTP": map[string]interface{}{
+							"PathPrefix": "/api",
+							"Header":     []interface{}{map[string]interface{}{"Name": "x-debug", "Present": true}},
+							"Methods":    []interface{}{"GET"},
+						},
+					},
+					"Destination": map[string]interface{}{
+						"Service":        "reviews",
+						"RequestTimeout": "3s",
+					},
+				},
+			},
+		}))
+	})
+
+	It("does not rewrite unchanged entries and deletes entries no longer desired", func() {
+		rule := &v1.RoutingRule{
+			Metadata:   core.Metadata{Name: "timeout", Namespace: "default"},
+			TargetMesh: consulMesh,
+			Timeout:    types.DurationProto(time.Second),
+		}
+		err := s.Sync(context.TODO(), snapshot(rule))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.ConfigEntryKeys()).To(Equal([]string{"service-router/reviews"}))
+		Expect(fake.ConfigWrites()).To(Equal(1))
+
+		err = s.Sync(context.TODO(), snapshot(rule))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.ConfigWrites()).To(Equal(1))
+
+		err = s.Sync(context.TODO(), snapshot())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.ConfigEntryKeys()).To(BeEmpty())
+	})
+
+	It("rejects splitting a subset of requests across multiple destinations", func() {
+		err := s.Sync(context.TODO(), snapshot(&v1.RoutingRule{
+			Metadata:     core.Metadata{Name: "trafficshifting", Namespace: "default"},
+			TargetMesh:   consulMesh,
+			Destinations: []*core.ResourceRef{ref("reviews")},
+			RequestMatchers: []*gloov1.Matcher{{
+				PathSpecifier: &gloov1.Matcher_Prefix{Prefix: "/api"},
+			}},
+			TrafficShifting: &v1.TrafficShifting{
+				Destinations: []*v1.WeightedDestination{
+					{Upstream: ref("reviews-v1"), Weight: 50},
+					{Upstream: ref("reviews-v2"), Weight: 50},
+				},
+			},
+		}))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("authenticates with the acl token of the mesh", func() {
+		s.Kube = fakeKubeWithAclToken("consul-acl-token")
+		snap := snapshot(&v1.RoutingRule{
+			Metadata:     core.Metadata{Name: "trafficshifting", Namespace: "default"},
+			TargetMesh:   consulMesh,
+			Destinations: []*core.ResourceRef{ref("reviews")},
+			TrafficShifting: &v1.TrafficShifting{
+				Destinations: []*v1.WeightedDestination{
+					{Upstream: ref("reviews-v1"), Weight: 50},
+					{Upstream: ref("reviews-v2"), Weight: 50},
+				},
+			},
+		})
+		snap.Meshes[""][0].GetConsul().AclTokenSecret = &core.ResourceRef{Name: "consul-token", Namespace: "supergloo-system"}
+		err := s.Sync(context.TODO(), snap)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.ConfigEntryKeys()).NotTo(BeEmpty())
+		Expect(fake.Tokens("config")).To(Equal([]string{"consul-acl-token"}))
+		Expect(fake.Tokens("kv")).To(Equal([]string{"consul-acl-token"}))
+	})
+})
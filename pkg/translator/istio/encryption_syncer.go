@@ -3,6 +3,8 @@ package istio
 import (
 	"context"
 
+	"github.com/hashicorp/go-multierror"
+
 	"github.com/solo-io/supergloo/pkg/secret"
 
 	"k8s.io/client-go/kubernetes"
@@ -10,6 +12,7 @@ import (
 	istiov1 "github.com/solo-io/supergloo/pkg/api/external/istio/encryption/v1"
 
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 )
 
 type EncryptionSyncer struct {
@@ -19,12 +22,13 @@ type EncryptionSyncer struct {
 }
 
 func (s *EncryptionSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	var multiErr *multierror.Error
 	for _, mesh := range snap.Meshes.List() {
 		if err := s.syncMesh(ctx, mesh, snap); err != nil {
-			return err
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
 		}
 	}
-	return nil
+	return multiErr.ErrorOrNil()
 }
 
 func (s *EncryptionSyncer) syncMesh(ctx context.Context, mesh *v1.Mesh, snap *v1.TranslatorSnapshot) error {
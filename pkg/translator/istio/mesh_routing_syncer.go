@@ -3,6 +3,7 @@ package istio
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/mitchellh/hashstructure"
@@ -19,6 +20,7 @@ import (
 	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
 	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 )
 
 type MeshRoutingSyncer struct {
@@ -31,7 +33,7 @@ type MeshRoutingSyncer struct {
 	writeSelector             map[string]string
 	destinationRuleReconciler v1alpha3.DestinationRuleReconciler
 	virtualServiceReconciler  v1alpha3.VirtualServiceReconciler
-	// ilackarms: todo: implement reporter
+	// writes the status of each routing rule, may be nil
 	reporter reporter.Reporter
 }
 
@@ -41,7 +43,7 @@ func NewMeshRoutingSyncer(writeNamespaces []string,
 	virtualServiceReconciler v1alpha3.VirtualServiceReconciler,
 	reporter reporter.Reporter) *MeshRoutingSyncer {
 	if writeSelector == nil {
-		writeSelector = map[string]string{"reconciler.solo.io": "supergloo.istio.routing"}
+		writeSelector = defaultWriteSelector()
 	}
 	return &MeshRoutingSyncer{
 		writeNamespaces:           writeNamespaces,
@@ -52,6 +54,10 @@ func NewMeshRoutingSyncer(writeNamespaces []string,
 	}
 }
 
+func defaultWriteSelector() map[string]string {
+	return map[string]string{"reconciler.solo.io": "supergloo.istio.routing"}
+}
+
 func sanitizeName(name string) string {
 	name = strings.Replace(name, ".", "-", -1)
 	name = strings.Replace(name, "[", "", -1)
@@ -88,22 +94,70 @@ func (s *MeshRoutingSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapsho
 	defer logger.Infof("end sync %v", snap.Hash())
 	logger.Debugf("%v", snap)
 
-	destinationRules, err := destinationRulesForUpstreams(rules, meshes, upstreams)
-	if err != nil {
-		return errors.Wrapf(err, "creating subsets from snapshot")
+	resourceErrs := make(reporter.ResourceErrors)
+	destinationRules, virtualServices, meshErrs := TranslateRoutingRules(snap, s.writeSelector, resourceErrs)
+	writeErr := s.writeIstioCrds(ctx, destinationRules, virtualServices)
+	if s.reporter != nil {
+		if err := s.reporter.WriteReports(ctx, resourceErrs, nil); err != nil {
+			writeErr = multierr.Append(writeErr, errors.Wrapf(err, "writing reports"))
+		}
 	}
+	return multierr.Append(meshErrs, writeErr)
+}
 
-	virtualServices, err := virtualServicesForRules(rules, meshes, upstreams)
-	if err != nil {
-		return errors.Wrapf(err, "creating virtual services from snapshot")
+// TranslateRoutingRules returns the destination rules and virtual services the MeshRoutingSyncer writes for the snapshot,
+// with the metadata they are written with. if writeSelector is nil, the default selector of the syncer is used.
+// invalid rules are left out of the translation and their errors are added to resourceErrs;
+// the errors of meshes which failed to translate are returned as MeshSyncErrors
+func TranslateRoutingRules(snap *v1.TranslatorSnapshot, writeSelector map[string]string, resourceErrs reporter.ResourceErrors) (v1alpha3.DestinationRuleList, v1alpha3.VirtualServiceList, error) {
+	if writeSelector == nil {
+		writeSelector = defaultWriteSelector()
+	}
+	meshes := snap.Meshes.List()
+	upstreams := snap.Upstreams.List()
+
+	// invalid rules are reported and left out of the translation.
+	// the rules are translated with their upstream selectors expanded
+	rules, expandedFrom := validateRules(snap.Routingrules.List(), meshes, upstreams, resourceErrs)
+	// the conflicts between the expanded rules are reported on the rules they were expanded from
+	translationErrs := make(reporter.ResourceErrors)
+
+	// each mesh is translated on its own so a mesh which fails to translate
+	// does not prevent the rules of the other meshes from being applied
+	var (
+		destinationRules v1alpha3.DestinationRuleList
+		virtualServices  v1alpha3.VirtualServiceList
+		meshErrs         error
+	)
+	for _, mesh := range meshes {
+		meshRules := shared.RulesForMesh(rules, mesh)
+		// an istio mesh without rules still needs destination rules for its traffic policy
+		if len(meshRules) == 0 && (mesh.GetIstio() == nil || mesh.TrafficPolicy == nil) {
+			continue
+		}
+		meshDestinationRules, err := destinationRulesForUpstreams(mesh, meshRules, upstreams, translationErrs)
+		if err != nil {
+			meshErrs = multierr.Append(meshErrs, shared.NewMeshSyncError(mesh, errors.Wrapf(err, "creating subsets from snapshot")))
+			continue
+		}
+		meshVirtualServices, err := virtualServicesForRules(meshRules, meshes, upstreams, translationErrs)
+		if err != nil {
+			meshErrs = multierr.Append(meshErrs, shared.NewMeshSyncError(mesh, errors.Wrapf(err, "creating virtual services from snapshot")))
+			continue
+		}
+		destinationRules = append(destinationRules, meshDestinationRules...)
+		virtualServices = append(virtualServices, meshVirtualServices...)
+	}
+	for rule, err := range translationErrs {
+		resourceErrs.AddError(expandedFrom[rule.(*v1.RoutingRule)], err)
 	}
 	for _, res := range destinationRules {
-		updateMetadataForWriting(&res.Metadata, s.writeSelector)
+		updateMetadataForWriting(&res.Metadata, writeSelector)
 	}
 	for _, res := range virtualServices {
-		updateMetadataForWriting(&res.Metadata, s.writeSelector)
+		updateMetadataForWriting(&res.Metadata, writeSelector)
 	}
-	return s.writeIstioCrds(ctx, destinationRules, virtualServices)
+	return destinationRules, virtualServices, meshErrs
 }
 
 func getIstioMeshForRule(rule *v1.RoutingRule, meshes v1.MeshList) (*v1.Istio, error) {
@@ -125,6 +179,38 @@ func getIstioMeshForRule(rule *v1.RoutingRule, meshes v1.MeshList) (*v1.Istio, e
 	return istioMesh.Istio, nil
 }
 
+// returns the valid rules which target an istio mesh, with their upstream selectors expanded,
+// along with the rule of the snapshot each of them was expanded from.
+// rules targeting other mesh types are handled (and reported) by their own syncers,
+// rules whose target mesh cannot be found are reported here
+func validateRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) (v1.RoutingRuleList, map[*v1.RoutingRule]*v1.RoutingRule) {
+	var istioRules v1.RoutingRuleList
+	expandedFrom := make(map[*v1.RoutingRule]*v1.RoutingRule)
+	for _, rule := range rules {
+		istioMesh, err := getIstioMeshForRule(rule, meshes)
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		if istioMesh == nil {
+			continue
+		}
+		expanded, err := shared.ExpandUpstreamSelectors(rule, upstreams)
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		if err := validateRule(expanded, istioMesh, upstreams); err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		resourceErrs.Accept(rule)
+		istioRules = append(istioRules, expanded)
+		expandedFrom[expanded] = rule
+	}
+	return istioRules, expandedFrom
+}
+
 func subsetName(labels map[string]string) string {
 	keys, values := stringutils.KeysAndValues(labels)
 	name := ""
@@ -136,82 +222,171 @@ func subsetName(labels map[string]string) string {
 }
 
 // destinationrules
-func destinationRulesForUpstreams(rules v1.RoutingRuleList, meshes v1.MeshList, upstreams gloov1.UpstreamList) (v1alpha3.DestinationRuleList, error) {
-	var meshesWithRouteRules v1.MeshList
-	for _, rule := range rules {
-		mesh, err := meshes.Find(rule.TargetMesh.Namespace, rule.TargetMesh.Name)
+func destinationRulesForUpstreams(mesh *v1.Mesh, rules v1.RoutingRuleList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) (v1alpha3.DestinationRuleList, error) {
+	mtlsEnabled := mesh.Encryption != nil && mesh.Encryption.TlsEnabled
+	labelsByHost := make(map[string][]map[string]string)
+	for _, us := range upstreams {
+		labels := getLabelsForUpstream(us)
+		host, err := getHostForUpstream(us)
 		if err != nil {
-			return nil, errors.Wrapf(err, "finding target mesh %v", rule.TargetMesh)
+			return nil, errors.Wrapf(err, "getting host for upstream")
 		}
-		if _, err := getIstioMeshForRule(rule, meshes); err != nil {
-			return nil, err
+		labelsByHost[host] = append(labelsByHost[host], labels)
+	}
+	portsByHost, err := getPortsByHost(upstreams)
+	if err != nil {
+		return nil, err
+	}
+	var destinationRules v1alpha3.DestinationRuleList
+	for host, labelSets := range labelsByHost {
+		var subsets []*v1alpha3.Subset
+	addUniqueSubsets:
+		for _, labels := range labelSets {
+			// upstreams for different ports of a service share their labels
+			name := subsetName(labels)
+			for _, added := range subsets {
+				if added.Name == name {
+					continue addUniqueSubsets
+				}
+			}
+			subsets = append(subsets, &v1alpha3.Subset{
+				Name:   name,
+				Labels: labels,
+			})
 		}
-		var found bool
-		for _, addedMesh := range meshesWithRouteRules {
-			if mesh == addedMesh {
-				found = true
-				break
+		trafficPolicy := &v1alpha3.TrafficPolicy{}
+		if mtlsEnabled {
+			trafficPolicy.Tls = &v1alpha3.TLSSettings{
+				Mode: v1alpha3.TLSSettings_ISTIO_MUTUAL,
 			}
 		}
-		if !found {
-			meshesWithRouteRules = append(meshesWithRouteRules, mesh)
+		trafficPolicy = overrideTrafficPolicy(trafficPolicy, mesh.TrafficPolicy)
+		ports := portsByHost[host]
+		hostRules, portRules, err := trafficPolicyRulesForHost(host, ports, rules, upstreams)
+		if err != nil {
+			return nil, err
 		}
+		trafficPolicy = overrideTrafficPolicy(trafficPolicy, mergeTrafficPolicies(hostRules, resourceErrs))
+		portPolicies := make(map[uint32]*v1.TrafficPolicy)
+		for port, rulesForPort := range portRules {
+			portPolicies[port] = mergeTrafficPolicies(rulesForPort, resourceErrs)
+		}
+		trafficPolicy.PortLevelSettings = portTrafficPolicies(ports, trafficPolicy, portPolicies)
+		if trafficPolicy.Equal(&v1alpha3.TrafficPolicy{}) {
+			trafficPolicy = nil
+		}
+		destinationRules = append(destinationRules, &v1alpha3.DestinationRule{
+			Metadata: core.Metadata{
+				Namespace: mesh.Metadata.Namespace,
+				Name:      mesh.Metadata.Name + "-" + host,
+			},
+			Host:          host,
+			TrafficPolicy: trafficPolicy,
+			Subsets:       subsets,
+		})
 	}
-	if len(meshesWithRouteRules) == 0 {
-		return nil, nil
+
+	return destinationRules.Sort(), nil
+}
+
+// returns the rules whose traffic policy applies to the whole host, and those which apply
+// to some of its ports only. a rule targets ports when its destinations are on a host with multiple ports
+func trafficPolicyRulesForHost(host string, ports []uint32, rules v1.RoutingRuleList, upstreams gloov1.UpstreamList) (v1.RoutingRuleList, map[uint32]v1.RoutingRuleList, error) {
+	var hostRules v1.RoutingRuleList
+	portRules := make(map[uint32]v1.RoutingRuleList)
+	for _, rule := range rules {
+		if rule.TrafficPolicy == nil {
+			continue
+		}
+		if len(rule.Destinations) == 0 {
+			hostRules = append(hostRules, rule)
+			continue
+		}
+		rulePorts, err := portsForRule(rule, host, upstreams)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rulePorts) == 0 {
+			// the rule does not apply to this host
+			continue
+		}
+		if len(ports) < 2 {
+			hostRules = append(hostRules, rule)
+			continue
+		}
+		for _, port := range rulePorts {
+			portRules[port] = append(portRules[port], rule)
+		}
 	}
+	return hostRules, portRules, nil
+}
 
-	var destinationRules v1alpha3.DestinationRuleList
-	for _, mesh := range meshesWithRouteRules {
-		mtlsEnabled := mesh.Encryption != nil && mesh.Encryption.TlsEnabled
-		labelsByHost := make(map[string][]map[string]string)
-		for _, us := range upstreams {
-			labels := getLabelsForUpstream(us)
-			host, err := getHostForUpstream(us)
-			if err != nil {
-				return nil, errors.Wrapf(err, "getting host for upstream")
-			}
-			labelsByHost[host] = append(labelsByHost[host], labels)
+// merges the traffic policies of rules which apply to the same host or port.
+// when rules define different values for the same setting, the value of the rule which takes precedence is used
+// and the conflict is reported on both rules
+func mergeTrafficPolicies(rules v1.RoutingRuleList, resourceErrs reporter.ResourceErrors) *v1.TrafficPolicy {
+	if len(rules) == 0 {
+		return nil
+	}
+	merged := &v1.TrafficPolicy{}
+	// the rule each setting of the merged policy is taken from
+	definedBy := make(map[string]*v1.RoutingRule)
+	for _, rule := range sortByPrecedence(rules) {
+		policy := rule.TrafficPolicy
+		if policy.LoadBalancer != nil && merged.LoadBalancer != nil && !policy.LoadBalancer.Equal(merged.LoadBalancer) {
+			reportConflict(definedBy["LoadBalancer"], rule, "LoadBalancer", resourceErrs)
 		}
-		for host, labelSets := range labelsByHost {
-			var subsets []*v1alpha3.Subset
-			for _, labels := range labelSets {
-				subsets = append(subsets, &v1alpha3.Subset{
-					Name:   subsetName(labels),
-					Labels: labels,
-				})
-			}
-			var trafficPolicy *v1alpha3.TrafficPolicy
-			if mtlsEnabled {
-				trafficPolicy = &v1alpha3.TrafficPolicy{
-					Tls: &v1alpha3.TLSSettings{
-						Mode: v1alpha3.TLSSettings_ISTIO_MUTUAL,
-					},
-				}
-			}
-			destinationRules = append(destinationRules, &v1alpha3.DestinationRule{
-				Metadata: core.Metadata{
-					Namespace: mesh.Metadata.Namespace,
-					Name:      mesh.Metadata.Name + "-" + host,
-				},
-				Host:          host,
-				TrafficPolicy: trafficPolicy,
-				Subsets:       subsets,
-			})
+		if policy.ConnectionPool != nil && merged.ConnectionPool != nil && !policy.ConnectionPool.Equal(merged.ConnectionPool) {
+			reportConflict(definedBy["ConnectionPool"], rule, "ConnectionPool", resourceErrs)
+		}
+		if policy.OutlierDetection != nil && merged.OutlierDetection != nil && !policy.OutlierDetection.Equal(merged.OutlierDetection) {
+			reportConflict(definedBy["OutlierDetection"], rule, "OutlierDetection", resourceErrs)
+		}
+		if policy.LoadBalancer != nil && merged.LoadBalancer == nil {
+			merged.LoadBalancer = policy.LoadBalancer
+			definedBy["LoadBalancer"] = rule
+		}
+		if policy.ConnectionPool != nil && merged.ConnectionPool == nil {
+			merged.ConnectionPool = policy.ConnectionPool
+			definedBy["ConnectionPool"] = rule
+		}
+		if policy.OutlierDetection != nil && merged.OutlierDetection == nil {
+			merged.OutlierDetection = policy.OutlierDetection
+			definedBy["OutlierDetection"] = rule
 		}
 	}
+	return merged
+}
 
-	return destinationRules.Sort(), nil
+// returns a copy of the istio traffic policy with the settings that are set in override replaced
+func overrideTrafficPolicy(policy *v1alpha3.TrafficPolicy, override *v1.TrafficPolicy) *v1alpha3.TrafficPolicy {
+	policy = &v1alpha3.TrafficPolicy{
+		LoadBalancer:      policy.LoadBalancer,
+		ConnectionPool:    policy.ConnectionPool,
+		OutlierDetection:  policy.OutlierDetection,
+		Tls:               policy.Tls,
+		PortLevelSettings: policy.PortLevelSettings,
+	}
+	if override == nil {
+		return policy
+	}
+	if override.LoadBalancer != nil {
+		policy.LoadBalancer = override.LoadBalancer
+	}
+	if override.ConnectionPool != nil {
+		policy.ConnectionPool = override.ConnectionPool
+	}
+	if override.OutlierDetection != nil {
+		policy.OutlierDetection = override.OutlierDetection
+	}
+	return policy
 }
 
 // virtualservices
-func virtualServicesForRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstreams gloov1.UpstreamList) (v1alpha3.VirtualServiceList, error) {
+func virtualServicesForRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) (v1alpha3.VirtualServiceList, error) {
 	// separate config changes for each mesh
 	meshesByRule := make(map[*v1.RoutingRule]v1.MeshList)
 	for _, rule := range rules {
-		if err := validateRule(rule, meshes); err != nil {
-			return nil, err
-		}
 		mesh, err := meshes.Find(rule.TargetMesh.Strings())
 		if err != nil {
 			// should never happen, error is already caught
@@ -245,9 +420,19 @@ func virtualServicesForRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstr
 	// for each host
 	// for each mesh
 	// create one virtualservice for that host-mesh's set of rules
+	// iterate the (sorted) rules rather than the map so the order of rules for each host is stable
 	rulesByMeshByHost := make(map[string]map[*v1.Mesh]v1.RoutingRuleList)
-	for rule, meshList := range meshesByRule {
+	for _, rule := range rules {
+		meshList := meshesByRule[rule]
 		for _, host := range hosts {
+			// rules with destinations only apply to the hosts of those destinations
+			ports, err := portsForRule(rule, host, upstreams)
+			if err != nil {
+				return nil, err
+			}
+			if len(rule.Destinations) > 0 && len(ports) == 0 {
+				continue
+			}
 			if rulesByMeshByHost[host] == nil {
 				rulesByMeshByHost[host] = make(map[*v1.Mesh]v1.RoutingRuleList)
 			}
@@ -261,7 +446,7 @@ func virtualServicesForRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstr
 	var virtualServices v1alpha3.VirtualServiceList
 	for host, rulesByMesh := range rulesByMeshByHost {
 		for mesh, rules := range rulesByMesh {
-			vs, err := virtualServiceForHost(host, rules, mesh, upstreams)
+			vs, err := virtualServiceForHost(host, rules, mesh, upstreams, resourceErrs)
 			if err != nil {
 				return nil, errors.Wrapf(err, "creating virtual service for rules %v", rules)
 			}
@@ -271,28 +456,101 @@ func virtualServicesForRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstr
 	return virtualServices, nil
 }
 
-func validateRule(rule *v1.RoutingRule, meshes v1.MeshList) error {
-	istioMesh, err := getIstioMeshForRule(rule, meshes)
-	if err != nil {
-		return err
-	}
+func validateRule(rule *v1.RoutingRule, istioMesh *v1.Istio, upstreams gloov1.UpstreamList) error {
 	// we can only write our crds to a namespace istio watches
 	// just pick the first one for now
 	// if empty, all namespaces are valid
-	validNamespaces := istioMesh.WatchNamespaces
-	if len(validNamespaces) == 0 {
-		return nil
+	if validNamespaces := istioMesh.WatchNamespaces; len(validNamespaces) > 0 {
+		var found bool
+		for _, ns := range validNamespaces {
+			if ns == rule.Metadata.Namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("routing rule %v is not in a namespace that belongs to target mesh",
+				rule.Metadata.Ref())
+		}
+	}
+	if err := validateConnectionRouting(rule); err != nil {
+		return err
 	}
-	var found bool
-	for _, ns := range validNamespaces {
-		if ns == rule.Metadata.Namespace {
-			found = true
-			break
+	destinations, err := upstreamsForRule(rule, upstreams)
+	if err != nil {
+		return err
+	}
+	for _, us := range destinations {
+		if _, err := getHostForUpstream(us); err != nil {
+			return errors.Wrapf(err, "invalid destination %v", us.Metadata.Ref())
+		}
+	}
+	for _, src := range rule.Sources {
+		if _, err := upstreams.Find(src.Strings()); err != nil {
+			return errors.Wrapf(err, "invalid source %v", src)
 		}
 	}
-	if !found {
-		return errors.Errorf("routing rule %v is not in a namespace that belongs to target mesh",
-			rule.Metadata.Ref())
+	if rule.TrafficShifting != nil {
+		for _, dest := range rule.TrafficShifting.Destinations {
+			if _, err := destinationForUpstream(dest.Upstream, upstreams); err != nil {
+				return errors.Wrapf(err, "invalid weighted destination")
+			}
+		}
+	}
+	if rule.Mirror != nil {
+		if _, err := destinationForUpstream(rule.Mirror.Upstream, upstreams); err != nil {
+			return errors.Wrapf(err, "invalid mirror")
+		}
+		if rule.Mirror.Percentage != nil && rule.Mirror.Percentage.Value > 100 {
+			return errors.Errorf("mirror percentage must be between 0 and 100, got %v", rule.Mirror.Percentage.Value)
+		}
+	}
+	return nil
+}
+
+// tcp and tls rules route connections rather than requests,
+// so none of the http features of a rule can be applied to them
+func validateConnectionRouting(rule *v1.RoutingRule) error {
+	if rule.TcpRouting == nil && rule.TlsRouting == nil {
+		return nil
+	}
+	if rule.TcpRouting != nil && rule.TlsRouting != nil {
+		return errors.Errorf("tcp_routing and tls_routing cannot both be specified")
+	}
+	var httpFeatures []string
+	if len(rule.RequestMatchers) > 0 {
+		httpFeatures = append(httpFeatures, "request_matchers")
+	}
+	if rule.FaultInjection != nil {
+		httpFeatures = append(httpFeatures, "fault_injection")
+	}
+	if rule.Timeout != nil {
+		httpFeatures = append(httpFeatures, "timeout")
+	}
+	if rule.Retries != nil {
+		httpFeatures = append(httpFeatures, "retries")
+	}
+	if rule.CorsPolicy != nil {
+		httpFeatures = append(httpFeatures, "cors_policy")
+	}
+	if rule.Mirror != nil {
+		httpFeatures = append(httpFeatures, "mirror")
+	}
+	if rule.HeaderManipulaition != nil {
+		httpFeatures = append(httpFeatures, "header_manipulaition")
+	}
+	if len(httpFeatures) > 0 {
+		return errors.Errorf("%v cannot be used with tcp or tls routing", strings.Join(httpFeatures, ", "))
+	}
+	if rule.TlsRouting != nil {
+		if len(rule.TlsRouting.Matchers) == 0 {
+			return errors.Errorf("tls routing requires at least one matcher")
+		}
+		for _, match := range rule.TlsRouting.Matchers {
+			if len(match.SniHosts) == 0 {
+				return errors.Errorf("tls matchers require at least one sni host")
+			}
+		}
 	}
 	return nil
 }
@@ -312,116 +570,216 @@ func upstreamsForRule(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) (gloo
 	return destinationUpstreams, nil
 }
 
-func mergeRulesByMatch(rules v1.RoutingRuleList) (v1.RoutingRuleList, error) {
+// rules with the same match are merged into a single rule.
+// when rules define different values for the same feature, the value of the rule which takes precedence is used
+// and the conflict is reported on both rules
+func mergeRulesByMatch(rules v1.RoutingRuleList, resourceErrs reporter.ResourceErrors) v1.RoutingRuleList {
 	rulesByUniqueMatch := make(map[uint64]v1.RoutingRuleList)
 	for _, rule := range rules {
 		type uniqueMatch struct {
 			Sources         []*core.ResourceRef
 			Destinations    []*core.ResourceRef
 			RequestMatchers []*gloov1.Matcher
+			TcpRouting      *v1.TcpRouting
+			TlsRouting      *v1.TlsRouting
 		}
 		hash, _ := hashstructure.Hash(uniqueMatch{
 			Sources:         rule.Sources,
 			Destinations:    rule.Destinations,
 			RequestMatchers: rule.RequestMatchers,
+			TcpRouting:      rule.TcpRouting,
+			TlsRouting:      rule.TlsRouting,
 		}, nil)
 		rulesByUniqueMatch[hash] = append(rulesByUniqueMatch[hash], rule)
 	}
 	var mergedRules v1.RoutingRuleList
 	for _, rulesForMatch := range rulesByUniqueMatch {
+		rulesForMatch = sortByPrecedence(rulesForMatch)
+		// the merged rule takes the precedence of its first rule, so that routes are ordered by precedence too
 		mergedRule := &v1.RoutingRule{
+			Metadata:        rulesForMatch[0].Metadata,
+			Priority:        rulesForMatch[0].Priority,
 			Sources:         rulesForMatch[0].Sources,
 			Destinations:    rulesForMatch[0].Destinations,
 			RequestMatchers: rulesForMatch[0].RequestMatchers,
+			TcpRouting:      rulesForMatch[0].TcpRouting,
+			TlsRouting:      rulesForMatch[0].TlsRouting,
 		}
+		// the rule each feature of the merged rule is taken from
+		definedBy := make(map[string]*v1.RoutingRule)
 		for _, rule := range rulesForMatch {
-			if rule.TrafficShifting != nil {
-				if mergedRule.TrafficShifting != nil {
-					return nil, errors.Errorf("TrafficShifting redefined in for match %v", rule.Metadata.Ref())
-				}
+			for _, feature := range conflictingFeatures(mergedRule, rule) {
+				reportConflict(definedBy[feature], rule, feature, resourceErrs)
+			}
+			if rule.TrafficShifting != nil && mergedRule.TrafficShifting == nil {
 				mergedRule.TrafficShifting = rule.TrafficShifting
+				definedBy["TrafficShifting"] = rule
 			}
-			if rule.FaultInjection != nil {
-				if mergedRule.FaultInjection != nil {
-					return nil, errors.Errorf("FaultInjection redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.FaultInjection != nil && mergedRule.FaultInjection == nil {
 				mergedRule.FaultInjection = rule.FaultInjection
+				definedBy["FaultInjection"] = rule
 			}
-			if rule.Timeout != nil {
-				if mergedRule.Timeout != nil {
-					return nil, errors.Errorf("Timeout redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.Timeout != nil && mergedRule.Timeout == nil {
 				mergedRule.Timeout = rule.Timeout
+				definedBy["Timeout"] = rule
 			}
-			if rule.Retries != nil {
-				if mergedRule.Retries != nil {
-					return nil, errors.Errorf("Retries redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.Retries != nil && mergedRule.Retries == nil {
 				mergedRule.Retries = rule.Retries
+				definedBy["Retries"] = rule
 			}
-			if rule.CorsPolicy != nil {
-				if mergedRule.CorsPolicy != nil {
-					return nil, errors.Errorf("CorsPolicy redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.CorsPolicy != nil && mergedRule.CorsPolicy == nil {
 				mergedRule.CorsPolicy = rule.CorsPolicy
+				definedBy["CorsPolicy"] = rule
 			}
-			if rule.Mirror != nil {
-				if mergedRule.Mirror != nil {
-					return nil, errors.Errorf("Mirror redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.Mirror != nil && mergedRule.Mirror == nil {
 				mergedRule.Mirror = rule.Mirror
+				definedBy["Mirror"] = rule
 			}
-			if rule.HeaderManipulaition != nil {
-				if mergedRule.HeaderManipulaition != nil {
-					return nil, errors.Errorf("HeaderManipulaition redefined in for match %v", rule.Metadata.Ref())
-				}
+			if rule.HeaderManipulaition != nil && mergedRule.HeaderManipulaition == nil {
 				mergedRule.HeaderManipulaition = rule.HeaderManipulaition
+				definedBy["HeaderManipulaition"] = rule
 			}
 		}
 		mergedRules = append(mergedRules, mergedRule)
 	}
-	return mergedRules.Sort(), nil
+	return sortByPrecedence(mergedRules)
+}
+
+// the features the rule defines with a different value than the merged rule
+func conflictingFeatures(mergedRule, rule *v1.RoutingRule) []string {
+	var conflicts []string
+	if rule.TrafficShifting != nil && mergedRule.TrafficShifting != nil && !rule.TrafficShifting.Equal(mergedRule.TrafficShifting) {
+		conflicts = append(conflicts, "TrafficShifting")
+	}
+	if rule.FaultInjection != nil && mergedRule.FaultInjection != nil && !rule.FaultInjection.Equal(mergedRule.FaultInjection) {
+		conflicts = append(conflicts, "FaultInjection")
+	}
+	if rule.Timeout != nil && mergedRule.Timeout != nil && !rule.Timeout.Equal(mergedRule.Timeout) {
+		conflicts = append(conflicts, "Timeout")
+	}
+	if rule.Retries != nil && mergedRule.Retries != nil && !rule.Retries.Equal(mergedRule.Retries) {
+		conflicts = append(conflicts, "Retries")
+	}
+	if rule.CorsPolicy != nil && mergedRule.CorsPolicy != nil && !rule.CorsPolicy.Equal(mergedRule.CorsPolicy) {
+		conflicts = append(conflicts, "CorsPolicy")
+	}
+	if rule.Mirror != nil && mergedRule.Mirror != nil && !rule.Mirror.Equal(mergedRule.Mirror) {
+		conflicts = append(conflicts, "Mirror")
+	}
+	if rule.HeaderManipulaition != nil && mergedRule.HeaderManipulaition != nil && !rule.HeaderManipulaition.Equal(mergedRule.HeaderManipulaition) {
+		conflicts = append(conflicts, "HeaderManipulaition")
+	}
+	return conflicts
+}
+
+// returns a copy of the rules ordered by precedence: highest priority first, then by namespace and name
+func sortByPrecedence(rules v1.RoutingRuleList) v1.RoutingRuleList {
+	sorted := append(v1.RoutingRuleList{}, rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].Metadata.Less(sorted[j].Metadata)
+	})
+	return sorted
+}
+
+// reports a conflict on both the rule which takes precedence and the rule which is overridden
+func reportConflict(precedent, overridden *v1.RoutingRule, feature string, resourceErrs reporter.ResourceErrors) {
+	addErrorOnce(precedent, errors.Errorf("%v conflicts with rule %v, this rule takes precedence",
+		feature, overridden.Metadata.Ref().Key()), resourceErrs)
+	addErrorOnce(overridden, errors.Errorf("%v conflicts with rule %v, which takes precedence",
+		feature, precedent.Metadata.Ref().Key()), resourceErrs)
+}
+
+// a rule applies to many hosts, so the same error can be found for each of them. only report it once
+func addErrorOnce(rule *v1.RoutingRule, err error, resourceErrs reporter.ResourceErrors) {
+	if existing := resourceErrs[rule]; existing != nil && strings.Contains(existing.Error(), err.Error()) {
+		return
+	}
+	resourceErrs.AddError(rule, err)
 }
 
-func virtualServiceForHost(host string, rules v1.RoutingRuleList, mesh *v1.Mesh, upstreams gloov1.UpstreamList) (*v1alpha3.VirtualService, error) {
-	rules, err := mergeRulesByMatch(rules)
+func virtualServiceForHost(host string, rules v1.RoutingRuleList, mesh *v1.Mesh, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) (*v1alpha3.VirtualService, error) {
+	rules = mergeRulesByMatch(rules, resourceErrs)
+
+	portsByHost, err := getPortsByHost(upstreams)
 	if err != nil {
-		return nil, errors.Wrapf(err, "route rules incompatible")
+		return nil, err
 	}
+	multiplePorts := len(portsByHost[host]) > 1
 
-	var istioRules []*v1alpha3.HTTPRoute
+	var (
+		istioRules []*v1alpha3.HTTPRoute
+		tcpRoutes  []*v1alpha3.TCPRoute
+		tlsRoutes  []*v1alpha3.TLSRoute
+	)
 	for _, rule := range rules {
-		// each rule gets its own HTTPRoute
+		// each rule gets its own HTTPRoute, TCPRoute or TLSRoute
 
-		// matcher is the same regardless of destination
-		// upstreams are used for our SOURCES here
-		// this requires upstreams to be created for our source pods
-		match, err := createIstioMatcher(rule, upstreams)
-		if err != nil {
-			return nil, errors.Wrapf(err, "creating istio matcher")
-		}
-
-		// default: single destination, original
-		route := []*v1alpha3.DestinationWeight{{
-			Destination: &v1alpha3.Destination{
-				Host: host,
-			},
-		}}
-		if rule.TrafficShifting != nil && len(rule.TrafficShifting.Destinations) > 0 {
-			route, err = createLoadBalancedRoute(rule.TrafficShifting.Destinations, upstreams)
+		// a rule whose destinations are specific ports of a host with multiple ports
+		// gets a route for each of those ports, otherwise it applies to every port
+		ports := []uint32{0}
+		if multiplePorts && len(rule.Destinations) > 0 {
+			ports, err = portsForRule(rule, host, upstreams)
 			if err != nil {
-				return nil, errors.Wrapf(err, "creating multi destination route")
+				return nil, err
 			}
 		}
-		httpRoute := &v1alpha3.HTTPRoute{
-			Match: match,
-			Route: route,
-		}
-		if err := addHttpFeatures(rule, httpRoute, upstreams); err != nil {
-			return nil, errors.Wrapf(err, "adding http features to route")
-		}
 
-		istioRules = append(istioRules, httpRoute)
+		for _, port := range ports {
+			// default: single destination, original
+			route := []*v1alpha3.DestinationWeight{{
+				Destination: &v1alpha3.Destination{
+					Host: host,
+					Port: portSelector(port),
+				},
+			}}
+			if rule.TrafficShifting != nil && len(rule.TrafficShifting.Destinations) > 0 {
+				route, err = createLoadBalancedRoute(rule.TrafficShifting.Destinations, upstreams)
+				if err != nil {
+					return nil, errors.Wrapf(err, "creating multi destination route")
+				}
+			}
+
+			switch {
+			case rule.TcpRouting != nil:
+				match, err := createTcpMatcher(rule, upstreams, port)
+				if err != nil {
+					return nil, errors.Wrapf(err, "creating tcp matcher")
+				}
+				tcpRoutes = append(tcpRoutes, &v1alpha3.TCPRoute{
+					Match: match,
+					Route: route,
+				})
+			case rule.TlsRouting != nil:
+				match, err := createTlsMatcher(rule, upstreams, port)
+				if err != nil {
+					return nil, errors.Wrapf(err, "creating tls matcher")
+				}
+				tlsRoutes = append(tlsRoutes, &v1alpha3.TLSRoute{
+					Match: match,
+					Route: route,
+				})
+			default:
+				// matcher is the same regardless of destination
+				// upstreams are used for our SOURCES here
+				// this requires upstreams to be created for our source pods
+				match, err := createIstioMatcher(rule, upstreams)
+				if err != nil {
+					return nil, errors.Wrapf(err, "creating istio matcher")
+				}
+				httpRoute := &v1alpha3.HTTPRoute{
+					Match: matchPort(match, port),
+					Route: route,
+				}
+				if err := addHttpFeatures(rule, httpRoute, upstreams); err != nil {
+					return nil, errors.Wrapf(err, "adding http features to route")
+				}
+
+				istioRules = append(istioRules, httpRoute)
+			}
+		}
 	}
 
 	return &v1alpha3.VirtualService{
@@ -435,6 +793,8 @@ func virtualServiceForHost(host string, rules v1.RoutingRuleList, mesh *v1.Mesh,
 		// and no ingresses
 		Gateways: []string{"mesh"},
 		Http:     istioRules,
+		Tcp:      tcpRoutes,
+		Tls:      tlsRoutes,
 		//[]*v1alpha3.HTTPRoute{{
 		//	Match: istioMatchers,
 		//	Route: istioDestinations,
@@ -442,7 +802,7 @@ func virtualServiceForHost(host string, rules v1.RoutingRuleList, mesh *v1.Mesh,
 	}, nil
 }
 
-func createIstioMatcher(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]*v1alpha3.HTTPMatchRequest, error) {
+func getSourceLabelSets(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]map[string]string, error) {
 	var sourceLabelSets []map[string]string
 	for _, src := range rule.Sources {
 		upstream, err := upstreams.Find(src.Strings())
@@ -452,6 +812,76 @@ func createIstioMatcher(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]
 		labels := getLabelsForUpstream(upstream)
 		sourceLabelSets = append(sourceLabelSets, labels)
 	}
+	return sourceLabelSets, nil
+}
+
+// returns a matcher for each tcp matcher and source of the rule,
+// or no matchers if the rule applies to every tcp connection
+func createTcpMatcher(rule *v1.RoutingRule, upstreams gloov1.UpstreamList, port uint32) ([]*v1alpha3.L4MatchAttributes, error) {
+	sourceLabelSets, err := getSourceLabelSets(rule, upstreams)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceLabelSets) == 0 {
+		sourceLabelSets = []map[string]string{nil}
+	}
+	matchers := rule.TcpRouting.Matchers
+	if len(matchers) == 0 {
+		matchers = []*v1.TcpMatcher{{}}
+	}
+	var istioMatcher []*v1alpha3.L4MatchAttributes
+	for _, match := range matchers {
+		for _, sourceLabels := range sourceLabelSets {
+			l4Match := &v1alpha3.L4MatchAttributes{
+				DestinationSubnets: match.DestinationSubnets,
+				Port:               match.Port,
+				SourceLabels:       sourceLabels,
+			}
+			if l4Match.Port == 0 {
+				l4Match.Port = port
+			}
+			if proto.Equal(l4Match, &v1alpha3.L4MatchAttributes{}) {
+				// catch-all
+				continue
+			}
+			istioMatcher = append(istioMatcher, l4Match)
+		}
+	}
+	return istioMatcher, nil
+}
+
+// returns a matcher for each tls matcher and source of the rule
+func createTlsMatcher(rule *v1.RoutingRule, upstreams gloov1.UpstreamList, port uint32) ([]*v1alpha3.TLSMatchAttributes, error) {
+	sourceLabelSets, err := getSourceLabelSets(rule, upstreams)
+	if err != nil {
+		return nil, err
+	}
+	if len(sourceLabelSets) == 0 {
+		sourceLabelSets = []map[string]string{nil}
+	}
+	var istioMatcher []*v1alpha3.TLSMatchAttributes
+	for _, match := range rule.TlsRouting.Matchers {
+		for _, sourceLabels := range sourceLabelSets {
+			tlsMatch := &v1alpha3.TLSMatchAttributes{
+				SniHosts:           match.SniHosts,
+				DestinationSubnets: match.DestinationSubnets,
+				Port:               match.Port,
+				SourceLabels:       sourceLabels,
+			}
+			if tlsMatch.Port == 0 {
+				tlsMatch.Port = port
+			}
+			istioMatcher = append(istioMatcher, tlsMatch)
+		}
+	}
+	return istioMatcher, nil
+}
+
+func createIstioMatcher(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]*v1alpha3.HTTPMatchRequest, error) {
+	sourceLabelSets, err := getSourceLabelSets(rule, upstreams)
+	if err != nil {
+		return nil, err
+	}
 
 	var istioMatcher []*v1alpha3.HTTPMatchRequest
 
@@ -554,53 +984,79 @@ func convertMatcher(sourceSelector map[string]string, match *gloov1.Matcher) *v1
 
 func createLoadBalancedRoute(destinations []*v1.WeightedDestination, upstreams gloov1.UpstreamList) ([]*v1alpha3.DestinationWeight, error) {
 	var istioDestinations []*v1alpha3.DestinationWeight
-	for _, dest := range destinations {
-		upstream, err := upstreams.Find(dest.Upstream.Strings())
+	weights := weightPercentages(destinations)
+	for i, dest := range destinations {
+		destination, err := destinationForUpstream(dest.Upstream, upstreams)
 		if err != nil {
 			return nil, errors.Wrapf(err, "invalid destination %v", dest)
 		}
-		host, err := getHostForUpstream(upstream)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get host for upstream")
-		}
-		labels := getLabelsForUpstream(upstream)
-		var port *v1alpha3.PortSelector
-		intPort, err := getPortForUpstream(upstream)
-		if err != nil {
-			return nil, errors.Wrapf(err, "getting port for upstream")
-		}
-		if intPort > 0 {
-			port = &v1alpha3.PortSelector{
-				Port: &v1alpha3.PortSelector_Number{Number: intPort},
-			}
-		}
 		istioDestinations = append(istioDestinations, &v1alpha3.DestinationWeight{
-			Destination: &v1alpha3.Destination{
-				Host:   host,
-				Subset: subsetName(labels),
-				Port:   port,
-			},
+			Destination: destination,
+			Weight:      weights[i],
 		})
 	}
 	return istioDestinations, nil
 }
 
+// returns the destination for the subset and port of the upstream's host that the upstream selects
+func destinationForUpstream(ref *core.ResourceRef, upstreams gloov1.UpstreamList) (*v1alpha3.Destination, error) {
+	if ref == nil {
+		return nil, errors.Errorf("an upstream must be specified")
+	}
+	upstream, err := upstreams.Find(ref.Strings())
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding upstream %v", ref)
+	}
+	host, err := getHostForUpstream(upstream)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get host for upstream")
+	}
+	labels := getLabelsForUpstream(upstream)
+	port, err := getPortForUpstream(upstream)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting port for upstream")
+	}
+	return &v1alpha3.Destination{
+		Host:   host,
+		Subset: subsetName(labels),
+		Port:   portSelector(port),
+	}, nil
+}
+
+// istio requires the weights of a route with multiple destinations to add up to 100,
+// so the weights are converted to their percentage of the total.
+// any remainder from rounding down goes to the last destination.
+// a single destination receives all the traffic, its weight is left unset
+func weightPercentages(destinations []*v1.WeightedDestination) []int32 {
+	weights := make([]int32, len(destinations))
+	var total uint32
+	for _, dest := range destinations {
+		total += dest.Weight
+	}
+	if len(destinations) < 2 || total == 0 {
+		return weights
+	}
+	var sum int32
+	for i, dest := range destinations {
+		weights[i] = int32(uint64(dest.Weight) * 100 / uint64(total))
+		sum += weights[i]
+	}
+	weights[len(weights)-1] += 100 - sum
+	return weights
+}
+
 func addHttpFeatures(rule *v1.RoutingRule, http *v1alpha3.HTTPRoute, upstreams gloov1.UpstreamList) error {
 	http.Fault = rule.FaultInjection
 	http.CorsPolicy = rule.CorsPolicy
 	http.Retries = rule.Retries
 	http.Timeout = rule.Timeout
 	if rule.Mirror != nil {
-		us, err := upstreams.Find(rule.Mirror.Upstream.Strings())
-		labels := getLabelsForUpstream(us)
-		host, err := getHostForUpstream(us)
+		mirror, err := destinationForUpstream(rule.Mirror.Upstream, upstreams)
 		if err != nil {
-			return errors.Wrapf(err, "getting host for upstream")
-		}
-		http.Mirror = &v1alpha3.Destination{
-			Host:   host,
-			Subset: subsetName(labels),
+			return errors.Wrapf(err, "invalid mirror")
 		}
+		http.Mirror = mirror
+		http.MirrorPercent = rule.Mirror.Percentage
 	}
 	if rule.HeaderManipulaition != nil {
 		//http.RemoveRequestHeaders = rule.HeaderManipulaition.RemoveRequestHeaders
@@ -717,7 +1173,8 @@ func getPortForUpstream(us *gloov1.Upstream) (uint32, error) {
 	case *gloov1.UpstreamSpec_Kube:
 		return specType.Kube.ServicePort, nil
 	case *gloov1.UpstreamSpec_Static:
-		// TODO(ilackarms): handle cases where port changes between hosts
+		// the first host of a static upstream is used as its istio host (see getHostForUpstream),
+		// so its port is the one that belongs to that host
 		for _, h := range specType.Static.Hosts {
 			return h.Port, nil
 		}
@@ -725,3 +1182,104 @@ func getPortForUpstream(us *gloov1.Upstream) (uint32, error) {
 	}
 	return 0, errors.Errorf("unknown upstream type")
 }
+
+// returns the sorted, distinct ports of the upstreams for each host
+func getPortsByHost(upstreams gloov1.UpstreamList) (map[string][]uint32, error) {
+	portsByHost := make(map[string][]uint32)
+	for _, us := range upstreams {
+		host, err := getHostForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting host for upstream")
+		}
+		port, err := getPortForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting port for upstream")
+		}
+		portsByHost[host] = appendUniquePort(portsByHost[host], port)
+	}
+	for _, ports := range portsByHost {
+		sort.Slice(ports, func(i, j int) bool {
+			return ports[i] < ports[j]
+		})
+	}
+	return portsByHost, nil
+}
+
+// returns the ports of the rule's destination upstreams on the given host
+func portsForRule(rule *v1.RoutingRule, host string, upstreams gloov1.UpstreamList) ([]uint32, error) {
+	destUpstreams, err := upstreamsForRule(rule, upstreams)
+	if err != nil {
+		return nil, err
+	}
+	var ports []uint32
+	for _, us := range destUpstreams {
+		usHost, err := getHostForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting host for upstream")
+		}
+		if usHost != host {
+			continue
+		}
+		port, err := getPortForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting port for upstream")
+		}
+		ports = appendUniquePort(ports, port)
+	}
+	return ports, nil
+}
+
+func appendUniquePort(ports []uint32, port uint32) []uint32 {
+	for _, p := range ports {
+		if p == port {
+			return ports
+		}
+	}
+	return append(ports, port)
+}
+
+// returns nil for port 0, which stands for any port
+func portSelector(port uint32) *v1alpha3.PortSelector {
+	if port == 0 {
+		return nil
+	}
+	return &v1alpha3.PortSelector{
+		Port: &v1alpha3.PortSelector_Number{Number: port},
+	}
+}
+
+// returns a copy of the matchers restricted to the given port, 0 leaves them unrestricted
+func matchPort(match []*v1alpha3.HTTPMatchRequest, port uint32) []*v1alpha3.HTTPMatchRequest {
+	if port == 0 {
+		return match
+	}
+	var portMatch []*v1alpha3.HTTPMatchRequest
+	for _, m := range match {
+		m = proto.Clone(m).(*v1alpha3.HTTPMatchRequest)
+		m.Port = port
+		portMatch = append(portMatch, m)
+	}
+	return portMatch
+}
+
+// hosts with multiple ports get a policy for each port.
+// older istio versions replace, rather than merge, the destination level policy
+// with the port level policy, so each port level policy repeats the destination level settings
+// before applying the overrides for that port
+func portTrafficPolicies(ports []uint32, hostPolicy *v1alpha3.TrafficPolicy, overrides map[uint32]*v1.TrafficPolicy) []*v1alpha3.TrafficPolicy_PortTrafficPolicy {
+	if len(ports) < 2 {
+		return nil
+	}
+	var portPolicies []*v1alpha3.TrafficPolicy_PortTrafficPolicy
+	for _, port := range ports {
+		policy := overrideTrafficPolicy(hostPolicy, overrides[port])
+		portPolicies = append(portPolicies, &v1alpha3.TrafficPolicy_PortTrafficPolicy{
+			Port:             portSelector(port),
+			LoadBalancer:     policy.LoadBalancer,
+			ConnectionPool:   policy.ConnectionPool,
+			OutlierDetection: policy.OutlierDetection,
+			Tls:              policy.Tls,
+		})
+	}
+	return portPolicies
+}
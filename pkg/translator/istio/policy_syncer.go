@@ -3,7 +3,10 @@ package istio
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -14,7 +17,6 @@ import (
 	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
 	glookubev1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
 	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
@@ -23,6 +25,8 @@ import (
 
 	"github.com/solo-io/supergloo/pkg/api/external/istio/rbac/v1alpha1"
 	"github.com/solo-io/supergloo/pkg/api/v1"
+	kubeutils "github.com/solo-io/supergloo/pkg/translator/kube"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
 )
 
 type PolicySyncer struct {
@@ -97,18 +101,19 @@ func (s *PolicySyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) er
 			// not our mesh, we don't care
 			continue
 		}
-		policy := mesh.Policy
+		// the rules of the mesh policies targeting the mesh are enforced along with its own
+		policy := shared.MergedPolicy(mesh, snap.Meshpolicies.List(), snap.Upstreams.List())
 		if policy == nil {
 			err := s.removePolicy(ctx)
 			if err != nil {
-				multiErr = multierror.Append(multiErr, err)
+				multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
 			}
 			continue
 		}
 
 		err := s.syncPolicy(ctx, snap.Upstreams, policy)
 		if err != nil {
-			multiErr = multierror.Append(multiErr, err)
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
 		}
 	}
 	return multiErr.ErrorOrNil()
@@ -156,20 +161,11 @@ func (s *PolicySyncer) syncPolicy(ctx context.Context, upstreams gloov1.Upstream
 		Selector: s.WriteSelector,
 	}
 
-	// we have a policy, write a global config
-	rcfg := s.globalConfig()
+	// we have a policy, write a global config.
+	// the rules which cannot be applied are reported once the others are
+	rcfg, serviceRoles, serviceRolesBindings, unapplied := TranslatePolicy(s.WriteNamespace, s.WriteSelector, s.kubeClient, upstreams, p)
 	var rcfgs v1alpha1.RbacConfigList
 	rcfgs = append(rcfgs, rcfg)
-	converter := convertToIstio{upstreams, p, s.kubeClient}
-	serviceRoles, serviceRolesBindings := converter.toIstio()
-
-	resources.UpdateMetadata(rcfg, s.updateMetadata)
-	for _, res := range serviceRoles {
-		resources.UpdateMetadata(res, s.updateMetadata)
-	}
-	for _, res := range serviceRolesBindings {
-		resources.UpdateMetadata(res, s.updateMetadata)
-	}
 
 	// get all namespaces
 	namespaces, err := s.kubeClient.CoreV1().Namespaces().List(kubemeta.ListOptions{})
@@ -206,34 +202,74 @@ func (s *PolicySyncer) syncPolicy(ctx context.Context, upstreams gloov1.Upstream
 	if err != nil {
 		return err
 	}
-	return nil
+	return unapplied
 
 }
 
-func (s *PolicySyncer) globalConfig() *v1alpha1.RbacConfig {
-	return &v1alpha1.RbacConfig{
+// TranslatePolicy returns the rbac config, service roles and service role bindings the PolicySyncer writes
+// to enforce the policy of an istio mesh, with the metadata they are written with.
+// the kube client is used to look up the service accounts of the source upstreams.
+// istio denies the requests which are not allowed, so the deny rules are applied by leaving out the rules they
+// override. the deny rules which cannot be applied this way are returned as an error
+func TranslatePolicy(writeNamespace string, writeSelector map[string]string, kubeClient kubernetes.Interface, upstreams gloov1.UpstreamsByNamespace, p *v1.Policy) (*v1alpha1.RbacConfig, v1alpha1.ServiceRoleList, v1alpha1.ServiceRoleBindingList, error) {
+	rcfg := globalConfig(writeNamespace, p)
+	rules, err := shared.AllowedRules(p, upstreams.List(), "istio")
+	converter := convertToIstio{upstreams, rules, kubeClient}
+	serviceRoles, serviceRolesBindings := converter.toIstio()
+
+	updateMetadata := func(meta *core.Metadata) {
+		updatePolicyMetadata(meta, writeSelector)
+	}
+	resources.UpdateMetadata(rcfg, updateMetadata)
+	for _, res := range serviceRoles {
+		resources.UpdateMetadata(res, updateMetadata)
+	}
+	for _, res := range serviceRolesBindings {
+		resources.UpdateMetadata(res, updateMetadata)
+	}
+	return rcfg, serviceRoles, serviceRolesBindings, err
+}
+
+func globalConfig(writeNamespace string, p *v1.Policy) *v1alpha1.RbacConfig {
+	rcfg := &v1alpha1.RbacConfig{
 		Metadata: core.Metadata{
 			// name MUST be default.
 			Name:      "default",
-			Namespace: s.WriteNamespace,
+			Namespace: writeNamespace,
 		},
 		Mode:            v1alpha1.RbacConfig_ON,
 		EnforcementMode: v1alpha1.EnforcementMode_ENFORCED,
 	}
+	switch p.Mode {
+	case v1.Policy_OFF:
+		rcfg.Mode = v1alpha1.RbacConfig_OFF
+		return rcfg
+	case v1.Policy_PERMISSIVE:
+		// the denied requests are only logged and reported in the metrics
+		rcfg.EnforcementMode = v1alpha1.EnforcementMode_PERMISSIVE
+	}
+	if len(p.Namespaces) > 0 {
+		// the services in the other namespaces allow all requests
+		namespaces := append([]string{}, p.Namespaces...)
+		sort.Strings(namespaces)
+		rcfg.Mode = v1alpha1.RbacConfig_ON_WITH_INCLUSION
+		rcfg.Inclusion = &v1alpha1.RbacConfig_Target{Namespaces: namespaces}
+	}
+	return rcfg
 }
 
 type convertToIstio struct {
 	upstreams  gloov1.UpstreamsByNamespace
-	policy     *v1.Policy
-	kubeClient *kubernetes.Clientset
+	rules      []*v1.Rule
+	kubeClient kubernetes.Interface
 }
 
-func (c *convertToIstio) toIstio() ([]*v1alpha1.ServiceRole, []*v1alpha1.ServiceRoleBinding) {
-	var roles []*v1alpha1.ServiceRole
-	var bindings []*v1alpha1.ServiceRoleBinding
+func (c *convertToIstio) toIstio() (v1alpha1.ServiceRoleList, v1alpha1.ServiceRoleBindingList) {
+	var roles v1alpha1.ServiceRoleList
+	var bindings v1alpha1.ServiceRoleBindingList
 
 	rulesByDest := map[core.ResourceRef][]*v1.Rule{}
-	for _, rule := range c.policy.Rules {
+	for _, rule := range c.rules {
 		if rule.Source == nil {
 			// TODO: should we return error instead?
 			continue
@@ -245,8 +281,7 @@ func (c *convertToIstio) toIstio() ([]*v1alpha1.ServiceRole, []*v1alpha1.Service
 		rulesByDest[*rule.Destination] = append(rulesByDest[*rule.Destination], rule)
 	}
 	// sort for idempotency
-	for _, rule := range c.policy.Rules {
-		dests := rulesByDest[*rule.Destination]
+	for _, dests := range rulesByDest {
 		sort.Slice(dests, func(i, j int) bool {
 			return dests[i].Source.String() > dests[j].Source.String()
 		})
@@ -264,104 +299,124 @@ func (c *convertToIstio) toIstio() ([]*v1alpha1.ServiceRole, []*v1alpha1.Service
 
 		// objects need to be written to the same namespaces as the service the control.
 		ns := destupstream.ServiceNamespace
-		// create an istio service role and binding:
-		name := "access-" + dest.Namespace + "-" + dest.Name
-		// create service role:
-		sr := &v1alpha1.ServiceRole{
-			Metadata: core.Metadata{
-				Name:      name,
-				Namespace: ns,
-			},
-			Rules: []*v1alpha1.AccessRule{
-				{
-					Methods: []string{"*"},
-					Services: []string{
-						c.svcname(destref),
-					},
+
+		// the rules which allow the same requests share a service role and binding
+		for _, access := range groupByAccess(c.svcname(destref), rules) {
+			// create an istio service role and binding:
+			name := "access-" + dest.Namespace + "-" + dest.Name + access.suffix
+			// create service role:
+			sr := &v1alpha1.ServiceRole{
+				Metadata: core.Metadata{
+					Name:      name,
+					Namespace: ns,
 				},
-			},
-		}
-		var subjects []*v1alpha1.Subject
-		for _, rule := range rules {
-			sourceupstream := c.getkube(*rule.Source)
-			if sourceupstream == nil {
-				continue
+				Rules: []*v1alpha1.AccessRule{access.rule},
 			}
-
-			sa := c.getsvcaccount(sourceupstream)
-			if sa == nil {
-				continue
+			var subjects []*v1alpha1.Subject
+			for _, rule := range access.rules {
+				sourceupstream := c.getkube(*rule.Source)
+				if sourceupstream == nil {
+					continue
+				}
+
+				sa := c.getsvcaccount(sourceupstream)
+				if sa == nil {
+					continue
+				}
+
+				subjects = append(subjects, &v1alpha1.Subject{
+					Properties: map[string]string{
+						"source.principal": c.principalame(*sa),
+					},
+				})
 			}
+			name = "bind-" + dest.Namespace + "-" + dest.Name + access.suffix
+			srb := &v1alpha1.ServiceRoleBinding{
+				Metadata: core.Metadata{
+					Name:      name,
+					Namespace: ns,
+				},
 
-			subjects = append(subjects, &v1alpha1.Subject{
-				Properties: map[string]string{
-					"source.principal": c.principalame(*sa),
+				Subjects: subjects,
+				RoleRef: &v1alpha1.RoleRef{
+					Kind: "ServiceRole",
+					Name: sr.Metadata.Name,
 				},
-			})
-		}
-		name = "bind-" + dest.Namespace + "-" + dest.Name
-		srb := &v1alpha1.ServiceRoleBinding{
-			Metadata: core.Metadata{
-				Name:      name,
-				Namespace: ns,
-			},
-
-			Subjects: subjects,
-			RoleRef: &v1alpha1.RoleRef{
-				Kind: "ServiceRole",
-				Name: sr.Metadata.Name,
-			},
+			}
+			roles = append(roles, sr)
+			bindings = append(bindings, srb)
 		}
-		roles = append(roles, sr)
-		bindings = append(bindings, srb)
 	}
 	return roles, bindings
 }
 
-func (c *convertToIstio) getsvcaccount(k *glookubev1.UpstreamSpec) *core.ResourceRef {
-	// istio manages identity in the level of service accounts.
-	// so we hueristicly figure out the service account for this upstream.
-	// we may consider changing our API in the future to better support this usecase
-
-	svcname := k.ServiceName
-	svcnamespace := k.ServiceNamespace
+// the policy rules of a destination which allow the same requests
+type ruleAccess struct {
+	rule  *v1alpha1.AccessRule
+	rules []*v1.Rule
+	// appended to the names of the service role and binding, empty if the requests are not restricted
+	// so the names stay the same as without restrictions
+	suffix string
+}
 
-	// find the services and get the selectors, and
-	svc, err := c.kubeClient.CoreV1().Services(svcnamespace).Get(svcname, kubemeta.GetOptions{})
-	if err != nil {
-		return nil
+func groupByAccess(service string, rules []*v1.Rule) []*ruleAccess {
+	var groups []*ruleAccess
+	byKey := map[string]*ruleAccess{}
+	for _, rule := range rules {
+		access := accessRule(service, rule)
+		key := access.String()
+		group, ok := byKey[key]
+		if !ok {
+			group = &ruleAccess{rule: access}
+			if len(rule.Methods)+len(rule.PathPrefixes)+len(rule.Ports) > 0 {
+				hash := fnv.New32a()
+				hash.Write([]byte(key))
+				group.suffix = fmt.Sprintf("-%08x", hash.Sum32())
+			}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		group.rules = append(group.rules, rule)
 	}
-	// get the pods from the selector
-	// get the first pod and grab its service account
-	opts := kubemeta.ListOptions{
-		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
-		Limit:         1,
+	return groups
+}
+
+// translates the restrictions of the rule on the requests to the service
+func accessRule(service string, rule *v1.Rule) *v1alpha1.AccessRule {
+	access := &v1alpha1.AccessRule{
+		Methods:  []string{"*"},
+		Services: []string{service},
 	}
-	pods, err := c.kubeClient.CoreV1().Pods(svcnamespace).List(opts)
-	if err != nil {
-		return nil
+	if len(rule.Methods) > 0 {
+		access.Methods = nil
+		for _, method := range rule.Methods {
+			access.Methods = append(access.Methods, strings.ToUpper(method))
+		}
+		sort.Strings(access.Methods)
 	}
-
-	if len(pods.Items) == 0 {
-		return nil
+	// istio matches the paths ending with "*" by their prefix
+	for _, prefix := range rule.PathPrefixes {
+		access.Paths = append(access.Paths, prefix+"*")
 	}
-	saname := pods.Items[0].Spec.ServiceAccountName
-	return &core.ResourceRef{
-		Name:      saname,
-		Namespace: svcnamespace,
+	sort.Strings(access.Paths)
+	if len(rule.Ports) > 0 {
+		var ports []string
+		for _, port := range rule.Ports {
+			ports = append(ports, strconv.Itoa(int(port)))
+		}
+		sort.Strings(ports)
+		access.Constraints = []*v1alpha1.AccessRule_Constraint{{Key: "destination.port", Values: ports}}
 	}
+	return access
+}
+
+func (c *convertToIstio) getsvcaccount(k *glookubev1.UpstreamSpec) *core.ResourceRef {
+	// istio manages identity in the level of service accounts.
+	return kubeutils.ServiceAccount(c.kubeClient, k)
 }
 
 func (c *convertToIstio) getkube(ref core.ResourceRef) *glookubev1.UpstreamSpec {
-	upstream, err := c.upstreams.List().Find(ref.Namespace, ref.Name)
-	if err != nil {
-		return nil
-	}
-	kubeupstream, ok := upstream.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Kube)
-	if !ok {
-		return nil
-	}
-	return kubeupstream.Kube
+	return kubeutils.KubeUpstream(c.upstreams.List(), ref)
 }
 
 func (c *convertToIstio) svcname(s core.ResourceRef) string {
@@ -371,15 +426,15 @@ func (c *convertToIstio) principalame(s core.ResourceRef) string {
 	return fmt.Sprintf("cluster.local/ns/%s/sa/%s", s.Namespace, s.Name)
 }
 
-func (s *PolicySyncer) updateMetadata(meta *core.Metadata) {
+func updatePolicyMetadata(meta *core.Metadata, writeSelector map[string]string) {
 	if meta.Annotations == nil {
 		meta.Annotations = make(map[string]string)
 	}
-	if meta.Labels == nil && len(s.WriteSelector) > 0 {
+	if meta.Labels == nil && len(writeSelector) > 0 {
 		meta.Labels = make(map[string]string)
 	}
 	meta.Annotations["created_by"] = "supergloo"
-	for k, v := range s.WriteSelector {
+	for k, v := range writeSelector {
 		meta.Labels[k] = v
 	}
 }
@@ -0,0 +1,154 @@
+package istio_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/static"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/rbac/v1alpha1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/istio"
+)
+
+var _ = Describe("PolicySyncer", func() {
+	upstream := func(name string, kube *kubernetes.UpstreamSpec) *gloov1.Upstream {
+		us := &gloov1.Upstream{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			// the service accounts of static upstreams are not looked up
+			UpstreamSpec: &gloov1.UpstreamSpec{UpstreamType: &gloov1.UpstreamSpec_Static{Static: &static.UpstreamSpec{}}},
+		}
+		if kube != nil {
+			us.UpstreamSpec.UpstreamType = &gloov1.UpstreamSpec_Kube{Kube: kube}
+		}
+		return us
+	}
+
+	It("enforces the policy in its mode for its namespaces", func() {
+		policy := &v1.Policy{
+			Mode:       v1.Policy_PERMISSIVE,
+			Namespaces: []string{"prod", "default"},
+		}
+		rbacConfig, _, _, err := TranslatePolicy("supergloo-system", nil, nil, nil, policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rbacConfig.Mode).To(Equal(v1alpha1.RbacConfig_ON_WITH_INCLUSION))
+		Expect(rbacConfig.EnforcementMode).To(Equal(v1alpha1.EnforcementMode_PERMISSIVE))
+		Expect(rbacConfig.Inclusion).To(Equal(&v1alpha1.RbacConfig_Target{Namespaces: []string{"default", "prod"}}))
+
+		policy.Mode = v1.Policy_OFF
+		rbacConfig, _, _, err = TranslatePolicy("supergloo-system", nil, nil, nil, policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rbacConfig.Mode).To(Equal(v1alpha1.RbacConfig_OFF))
+		Expect(rbacConfig.Inclusion).To(BeNil())
+
+		policy = &v1.Policy{}
+		rbacConfig, _, _, err = TranslatePolicy("supergloo-system", nil, nil, nil, policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rbacConfig.Mode).To(Equal(v1alpha1.RbacConfig_ON))
+		Expect(rbacConfig.EnforcementMode).To(Equal(v1alpha1.EnforcementMode_ENFORCED))
+	})
+
+	It("leaves out the rules overridden by deny rules", func() {
+		upstreams := gloov1.UpstreamList{
+			upstream("reviews", &kubernetes.UpstreamSpec{ServiceName: "reviews", ServiceNamespace: "default", ServicePort: 9080}),
+			upstream("ratings", &kubernetes.UpstreamSpec{ServiceName: "ratings", ServiceNamespace: "staging", ServicePort: 9080}),
+			upstream("productpage", nil),
+			upstream("details", nil),
+		}
+		source := &core.ResourceRef{Name: "productpage", Namespace: "gloo-system"}
+		details := &core.ResourceRef{Name: "details", Namespace: "gloo-system"}
+		reviews := &core.ResourceRef{Name: "reviews", Namespace: "gloo-system"}
+		ratings := &core.ResourceRef{Name: "ratings", Namespace: "gloo-system"}
+		policy := &v1.Policy{
+			Namespaces: []string{"default"},
+			Rules: []*v1.Rule{
+				{Source: source, Destination: reviews},
+				{Source: source, Destination: reviews, Methods: []string{"GET"}},
+				{Source: source, Destination: ratings},
+				{Source: details, Destination: reviews},
+			},
+			DenyRules: []*v1.Rule{
+				{Source: source, Destination: reviews, Methods: []string{"DELETE"}},
+				{Source: source, Destination: ratings},
+			},
+		}
+
+		_, serviceRoles, serviceRoleBindings, err := TranslatePolicy("supergloo-system", nil, nil, upstreams.ByNamespace(), policy)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("deny rule from gloo-system.productpage to gloo-system.reviews denies all the requests"))
+		Expect(err.Error()).To(ContainSubstring("the policy is not enforced for the services in namespace staging"))
+		// the only rule left is the one from details
+		Expect(serviceRoles).To(HaveLen(1))
+		Expect(serviceRoles[0].Metadata.Name).To(Equal("access-gloo-system-reviews"))
+		Expect(serviceRoleBindings).To(HaveLen(1))
+	})
+
+	It("translates the restrictions of the rules into the access rules of separate service roles", func() {
+		upstreams := gloov1.UpstreamList{
+			{
+				Metadata: core.Metadata{Name: "reviews", Namespace: "gloo-system"},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      "reviews",
+							ServiceNamespace: "default",
+							ServicePort:      9080,
+						},
+					},
+				},
+			},
+			// the service accounts of static upstreams are not looked up
+			{
+				Metadata: core.Metadata{Name: "productpage", Namespace: "gloo-system"},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Static{Static: &static.UpstreamSpec{}},
+				},
+			},
+		}
+		source := &core.ResourceRef{Name: "productpage", Namespace: "gloo-system"}
+		destination := &core.ResourceRef{Name: "reviews", Namespace: "gloo-system"}
+		policy := &v1.Policy{
+			Rules: []*v1.Rule{
+				{Source: source, Destination: destination},
+				{
+					Source:       source,
+					Destination:  destination,
+					Methods:      []string{"post", "GET"},
+					PathPrefixes: []string{"/reviews/"},
+					Ports:        []uint32{9080},
+				},
+			},
+		}
+
+		_, serviceRoles, serviceRoleBindings, err := TranslatePolicy("supergloo-system", nil, nil, upstreams.ByNamespace(), policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceRoles).To(HaveLen(2))
+		Expect(serviceRoleBindings).To(HaveLen(2))
+
+		unrestricted, err := serviceRoles.Find("default", "access-gloo-system-reviews")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unrestricted.Rules).To(Equal([]*v1alpha1.AccessRule{{
+			Services: []string{"reviews.default.svc.cluster.local"},
+			Methods:  []string{"*"},
+		}}))
+
+		var restricted *v1alpha1.ServiceRole
+		for _, role := range serviceRoles {
+			if role != unrestricted {
+				restricted = role
+			}
+		}
+		Expect(restricted.Metadata.Name).To(HavePrefix("access-gloo-system-reviews-"))
+		Expect(restricted.Rules).To(Equal([]*v1alpha1.AccessRule{{
+			Services:    []string{"reviews.default.svc.cluster.local"},
+			Methods:     []string{"GET", "POST"},
+			Paths:       []string{"/reviews/*"},
+			Constraints: []*v1alpha1.AccessRule_Constraint{{Key: "destination.port", Values: []string{"9080"}}},
+		}}))
+
+		for _, binding := range serviceRoleBindings {
+			Expect(binding.Metadata.Name).To(Equal("bind-" + binding.RoleRef.Name[len("access-"):]))
+		}
+	})
+})
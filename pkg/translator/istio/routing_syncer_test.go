@@ -2,12 +2,18 @@ package istio_test
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
 
 	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
 	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
@@ -195,4 +201,953 @@ var _ = Describe("RoutingSyncer", func() {
 		}))
 
 	})
+
+	It("reports the status of each rule and skips invalid rules", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		mesh := &core.ResourceRef{Name: "name", Namespace: namespace}
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:   core.Metadata{Name: "timeout", Namespace: namespace},
+				TargetMesh: mesh,
+				Timeout:    &types.Duration{Seconds: 1},
+			},
+			{
+				Metadata:   core.Metadata{Name: "missing-mesh", Namespace: namespace},
+				TargetMesh: &core.ResourceRef{Name: "missing", Namespace: namespace},
+				Timeout:    &types.Duration{Seconds: 1},
+			},
+			{
+				Metadata:     core.Metadata{Name: "missing-upstream", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{{Name: "missing", Namespace: namespace}},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{
+						Istio: &v1.Istio{},
+					},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {{
+					Metadata: core.Metadata{Name: "default-reviews-9080", Namespace: namespace},
+					UpstreamSpec: &gloov1.UpstreamSpec{
+						UpstreamType: &gloov1.UpstreamSpec_Kube{
+							Kube: &kubernetes.UpstreamSpec{
+								ServiceName:      "reviews",
+								ServiceNamespace: "default",
+								ServicePort:      9080,
+								Selector:         map[string]string{"app": "reviews"},
+							},
+						},
+					},
+				}},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("timeout").State).To(Equal(core.Status_Accepted))
+		Expect(status("missing-mesh").State).To(Equal(core.Status_Rejected))
+		Expect(status("missing-mesh").Reason).To(ContainSubstring("finding target mesh"))
+		Expect(status("missing-upstream").State).To(Equal(core.Status_Rejected))
+		Expect(status("missing-upstream").Reason).To(ContainSubstring("invalid destination"))
+
+		// the valid rules are still applied
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Http).To(HaveLen(1))
+		Expect(vs[0].Http[0].Timeout).To(Equal(&types.Duration{Seconds: 1}))
+	})
+
+	It("targets a single port of a host with multiple ports", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			nil,
+		)
+
+		upstream := func(port uint32) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: fmt.Sprintf("default-reviews-%v", port), Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      "reviews",
+							ServiceNamespace: "default",
+							ServicePort:      port,
+							Selector:         map[string]string{"app": "reviews"},
+						},
+					},
+				},
+			}
+		}
+		mesh := &core.ResourceRef{Name: "name", Namespace: namespace}
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata:   core.Metadata{Name: "name", Namespace: namespace},
+					MeshType:   &v1.Mesh_Istio{Istio: &v1.Istio{}},
+					Encryption: &v1.Encryption{TlsEnabled: true},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {upstream(9080), upstream(9090)},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{
+				"": {
+					{
+						Metadata:     core.Metadata{Name: "grpc-timeout", Namespace: namespace},
+						TargetMesh:   mesh,
+						Destinations: []*core.ResourceRef{{Name: "default-reviews-9090", Namespace: namespace}},
+						Timeout:      &types.Duration{Seconds: 1},
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		tls := &v1alpha3.TLSSettings{Mode: v1alpha3.TLSSettings_ISTIO_MUTUAL}
+		port := func(port uint32) *v1alpha3.PortSelector {
+			return &v1alpha3.PortSelector{Port: &v1alpha3.PortSelector_Number{Number: port}}
+		}
+		dr, err := drClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dr).To(HaveLen(1))
+		Expect(dr[0].Subsets).To(Equal([]*v1alpha3.Subset{
+			{Name: "app-reviews", Labels: map[string]string{"app": "reviews"}},
+		}))
+		Expect(dr[0].TrafficPolicy).To(Equal(&v1alpha3.TrafficPolicy{
+			Tls: tls,
+			PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{
+				{Port: port(9080), Tls: tls},
+				{Port: port(9090), Tls: tls},
+			},
+		}))
+
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Http).To(Equal([]*v1alpha3.HTTPRoute{{
+			Match: []*v1alpha3.HTTPMatchRequest{{
+				Uri:  &v1alpha3.StringMatch{MatchType: &v1alpha3.StringMatch_Prefix{Prefix: "/"}},
+				Port: 9090,
+			}},
+			Route: []*v1alpha3.DestinationWeight{{
+				Destination: &v1alpha3.Destination{
+					Host: "reviews.default.svc.cluster.local",
+					Port: port(9090),
+				},
+			}},
+			Timeout: &types.Duration{Seconds: 1},
+		}}))
+	})
+
+	It("creates tcp and tls routes", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		upstream := func(name, service string, port uint32, labels map[string]string) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: name, Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      service,
+							ServiceNamespace: "default",
+							ServicePort:      port,
+							Selector:         labels,
+						},
+					},
+				},
+			}
+		}
+		ref := func(name string) *core.ResourceRef {
+			return &core.ResourceRef{Name: name, Namespace: namespace}
+		}
+		mesh := ref("name")
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:     core.Metadata{Name: "mysql", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("mysql")},
+				TcpRouting:   &v1.TcpRouting{},
+				TrafficShifting: &v1.TrafficShifting{
+					Destinations: []*v1.WeightedDestination{
+						{Upstream: ref("mysql-v1"), Weight: 1},
+						{Upstream: ref("mysql-v2"), Weight: 2},
+					},
+				},
+			},
+			{
+				Metadata:     core.Metadata{Name: "ldap", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("ldap")},
+				TlsRouting: &v1.TlsRouting{
+					Matchers: []*v1.TlsMatcher{{SniHosts: []string{"ldap.example.com"}}},
+				},
+			},
+			{
+				Metadata:     core.Metadata{Name: "tcp-timeout", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("mysql")},
+				TcpRouting:   &v1.TcpRouting{Matchers: []*v1.TcpMatcher{{Port: 3306}}},
+				Timeout:      &types.Duration{Seconds: 1},
+			},
+			{
+				Metadata:     core.Metadata{Name: "tls-no-sni", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("ldap")},
+				TlsRouting:   &v1.TlsRouting{Matchers: []*v1.TlsMatcher{{Port: 636}}},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {
+					upstream("mysql", "mysql", 3306, map[string]string{"app": "mysql"}),
+					upstream("mysql-v1", "mysql", 3306, map[string]string{"app": "mysql", "version": "v1"}),
+					upstream("mysql-v2", "mysql", 3306, map[string]string{"app": "mysql", "version": "v2"}),
+					upstream("ldap", "ldap", 636, map[string]string{"app": "ldap"}),
+				},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("mysql").State).To(Equal(core.Status_Accepted))
+		Expect(status("ldap").State).To(Equal(core.Status_Accepted))
+		Expect(status("tcp-timeout").State).To(Equal(core.Status_Rejected))
+		Expect(status("tcp-timeout").Reason).To(ContainSubstring("timeout cannot be used with tcp or tls routing"))
+		Expect(status("tls-no-sni").State).To(Equal(core.Status_Rejected))
+		Expect(status("tls-no-sni").Reason).To(ContainSubstring("tls matchers require at least one sni host"))
+
+		mysql, err := vsClient.Read(namespace, "name-mysql-default-svc-cluster-local", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mysql.Http).To(BeEmpty())
+		Expect(mysql.Tls).To(BeEmpty())
+		port := &v1alpha3.PortSelector{Port: &v1alpha3.PortSelector_Number{Number: 3306}}
+		Expect(mysql.Tcp).To(Equal([]*v1alpha3.TCPRoute{{
+			Route: []*v1alpha3.DestinationWeight{
+				{
+					Destination: &v1alpha3.Destination{Host: "mysql.default.svc.cluster.local", Subset: "app-mysql-version-v1", Port: port},
+					Weight:      33,
+				},
+				{
+					Destination: &v1alpha3.Destination{Host: "mysql.default.svc.cluster.local", Subset: "app-mysql-version-v2", Port: port},
+					Weight:      67,
+				},
+			},
+		}}))
+
+		ldap, err := vsClient.Read(namespace, "name-ldap-default-svc-cluster-local", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ldap.Http).To(BeEmpty())
+		Expect(ldap.Tcp).To(BeEmpty())
+		Expect(ldap.Tls).To(Equal([]*v1alpha3.TLSRoute{{
+			Match: []*v1alpha3.TLSMatchAttributes{{SniHosts: []string{"ldap.example.com"}}},
+			Route: []*v1alpha3.DestinationWeight{{
+				Destination: &v1alpha3.Destination{Host: "ldap.default.svc.cluster.local"},
+			}},
+		}}))
+	})
+
+	It("applies the traffic policy of the mesh and overrides it with the policies of rules", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		upstream := func(service string, port uint32) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: fmt.Sprintf("default-%v-%v", service, port), Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      service,
+							ServiceNamespace: "default",
+							ServicePort:      port,
+							Selector:         map[string]string{"app": service},
+						},
+					},
+				},
+			}
+		}
+		leastConn := &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_LEAST_CONN},
+		}
+		random := &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{Simple: v1alpha3.LoadBalancerSettings_RANDOM},
+		}
+		connectionPool := &v1alpha3.ConnectionPoolSettings{
+			Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 100},
+		}
+		outlierDetection := &v1alpha3.OutlierDetection{
+			ConsecutiveErrors: 5,
+			Interval:          &types.Duration{Seconds: 10},
+		}
+		ref := func(name string) *core.ResourceRef {
+			return &core.ResourceRef{Name: name, Namespace: namespace}
+		}
+		mesh := ref("name")
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:      core.Metadata{Name: "ratings-lb", Namespace: namespace},
+				TargetMesh:    mesh,
+				Destinations:  []*core.ResourceRef{ref("default-ratings-9080")},
+				TrafficPolicy: &v1.TrafficPolicy{LoadBalancer: random},
+			},
+			{
+				Metadata:      core.Metadata{Name: "ratings-lb-redefined", Namespace: namespace},
+				TargetMesh:    mesh,
+				Destinations:  []*core.ResourceRef{ref("default-ratings-9080")},
+				TrafficPolicy: &v1.TrafficPolicy{LoadBalancer: leastConn},
+			},
+			{
+				Metadata:      core.Metadata{Name: "reviews-outlier", Namespace: namespace},
+				TargetMesh:    mesh,
+				Destinations:  []*core.ResourceRef{ref("default-reviews-9090")},
+				TrafficPolicy: &v1.TrafficPolicy{OutlierDetection: outlierDetection},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+					TrafficPolicy: &v1.TrafficPolicy{
+						LoadBalancer:   leastConn,
+						ConnectionPool: connectionPool,
+					},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {upstream("ratings", 9080), upstream("reviews", 9080), upstream("reviews", 9090)},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("reviews-outlier").State).To(Equal(core.Status_Accepted))
+		// the conflict is reported on both rules, the first one takes precedence
+		Expect(status("ratings-lb").State).To(Equal(core.Status_Rejected))
+		Expect(status("ratings-lb").Reason).To(ContainSubstring("LoadBalancer conflicts with rule test.ratings-lb-redefined, this rule takes precedence"))
+		Expect(status("ratings-lb-redefined").State).To(Equal(core.Status_Rejected))
+		Expect(status("ratings-lb-redefined").Reason).To(ContainSubstring("LoadBalancer conflicts with rule test.ratings-lb, which takes precedence"))
+
+		ratings, err := drClient.Read(namespace, "name-ratings-default-svc-cluster-local", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ratings.TrafficPolicy).To(Equal(&v1alpha3.TrafficPolicy{
+			LoadBalancer:   random,
+			ConnectionPool: connectionPool,
+		}))
+
+		// the outlier detection only applies to the port of the rule's destination
+		port := func(port uint32) *v1alpha3.PortSelector {
+			return &v1alpha3.PortSelector{Port: &v1alpha3.PortSelector_Number{Number: port}}
+		}
+		reviews, err := drClient.Read(namespace, "name-reviews-default-svc-cluster-local", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reviews.TrafficPolicy).To(Equal(&v1alpha3.TrafficPolicy{
+			LoadBalancer:   leastConn,
+			ConnectionPool: connectionPool,
+			PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{
+				{Port: port(9080), LoadBalancer: leastConn, ConnectionPool: connectionPool},
+				{Port: port(9090), LoadBalancer: leastConn, ConnectionPool: connectionPool, OutlierDetection: outlierDetection},
+			},
+		}))
+	})
+
+	It("creates destination rules for the traffic policy of a mesh without routing rules", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			nil,
+		)
+		connectionPool := &v1alpha3.ConnectionPoolSettings{
+			Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{MaxRetries: 3},
+		}
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata:      core.Metadata{Name: "name", Namespace: namespace},
+					MeshType:      &v1.Mesh_Istio{Istio: &v1.Istio{}},
+					TrafficPolicy: &v1.TrafficPolicy{ConnectionPool: connectionPool},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {{
+					Metadata: core.Metadata{Name: "default-reviews-9080", Namespace: namespace},
+					UpstreamSpec: &gloov1.UpstreamSpec{
+						UpstreamType: &gloov1.UpstreamSpec_Kube{
+							Kube: &kubernetes.UpstreamSpec{
+								ServiceName:      "reviews",
+								ServiceNamespace: "default",
+								ServicePort:      9080,
+							},
+						},
+					},
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		dr, err := drClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dr).To(HaveLen(1))
+		Expect(dr[0].TrafficPolicy).To(Equal(&v1alpha3.TrafficPolicy{ConnectionPool: connectionPool}))
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(BeEmpty())
+	})
+
+	It("mirrors a percentage of the requests to an upstream", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		upstream := func(name string, labels map[string]string) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: name, Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      "reviews",
+							ServiceNamespace: "default",
+							ServicePort:      9080,
+							Selector:         labels,
+						},
+					},
+				},
+			}
+		}
+		ref := func(name string) *core.ResourceRef {
+			return &core.ResourceRef{Name: name, Namespace: namespace}
+		}
+		mesh := ref("name")
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:     core.Metadata{Name: "mirror", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("reviews-v1")},
+				Mirror: &v1.Mirror{
+					Upstream:   ref("reviews-v2"),
+					Percentage: &types.UInt32Value{Value: 10},
+				},
+			},
+			{
+				Metadata:     core.Metadata{Name: "mirror-too-much", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("reviews-v1")},
+				Mirror: &v1.Mirror{
+					Upstream:   ref("reviews-v2"),
+					Percentage: &types.UInt32Value{Value: 150},
+				},
+			},
+			{
+				Metadata:     core.Metadata{Name: "mirror-missing-upstream", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: []*core.ResourceRef{ref("reviews-v1")},
+				Mirror:       &v1.Mirror{Upstream: ref("reviews-v3")},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {
+					upstream("reviews-v1", map[string]string{"app": "reviews", "version": "v1"}),
+					upstream("reviews-v2", map[string]string{"app": "reviews", "version": "v2"}),
+				},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("mirror").State).To(Equal(core.Status_Accepted))
+		Expect(status("mirror-too-much").State).To(Equal(core.Status_Rejected))
+		Expect(status("mirror-too-much").Reason).To(ContainSubstring("mirror percentage must be between 0 and 100"))
+		Expect(status("mirror-missing-upstream").State).To(Equal(core.Status_Rejected))
+		Expect(status("mirror-missing-upstream").Reason).To(ContainSubstring("invalid mirror"))
+
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Http).To(HaveLen(1))
+		Expect(vs[0].Http[0].Mirror).To(Equal(&v1alpha3.Destination{
+			Host:   "reviews.default.svc.cluster.local",
+			Subset: "app-reviews-version-v2",
+			Port:   &v1alpha3.PortSelector{Port: &v1alpha3.PortSelector_Number{Number: 9080}},
+		}))
+		Expect(vs[0].Http[0].MirrorPercent).To(Equal(&types.UInt32Value{Value: 10}))
+	})
+
+	It("reports conflicting rules on both of them and applies the one with the highest priority", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		mesh := &core.ResourceRef{Name: "name", Namespace: namespace}
+		destinations := []*core.ResourceRef{{Name: "default-reviews-9080", Namespace: namespace}}
+		retries := &v1alpha3.HTTPRetry{Attempts: 3}
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:     core.Metadata{Name: "a-low-priority", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: destinations,
+				Timeout:      &types.Duration{Seconds: 1},
+				Retries:      retries,
+			},
+			{
+				Metadata:     core.Metadata{Name: "b-high-priority", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: destinations,
+				Timeout:      &types.Duration{Seconds: 2},
+				Priority:     10,
+			},
+			{
+				Metadata:     core.Metadata{Name: "c-same-timeout", Namespace: namespace},
+				TargetMesh:   mesh,
+				Destinations: destinations,
+				Timeout:      &types.Duration{Seconds: 2},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {{
+					Metadata: core.Metadata{Name: "default-reviews-9080", Namespace: namespace},
+					UpstreamSpec: &gloov1.UpstreamSpec{
+						UpstreamType: &gloov1.UpstreamSpec_Kube{
+							Kube: &kubernetes.UpstreamSpec{
+								ServiceName:      "reviews",
+								ServiceNamespace: "default",
+								ServicePort:      9080,
+							},
+						},
+					},
+				}},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("b-high-priority").State).To(Equal(core.Status_Rejected))
+		Expect(status("b-high-priority").Reason).To(ContainSubstring("Timeout conflicts with rule test.a-low-priority, this rule takes precedence"))
+		Expect(status("a-low-priority").State).To(Equal(core.Status_Rejected))
+		Expect(status("a-low-priority").Reason).To(ContainSubstring("Timeout conflicts with rule test.b-high-priority, which takes precedence"))
+		// the same value is not a conflict
+		Expect(status("c-same-timeout").State).To(Equal(core.Status_Accepted))
+
+		// the features which do not conflict are still merged
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Http).To(HaveLen(1))
+		Expect(vs[0].Http[0].Timeout).To(Equal(&types.Duration{Seconds: 2}))
+		Expect(vs[0].Http[0].Retries).To(Equal(retries))
+	})
+
+	It("expands the upstream selectors of the rules without writing them back", func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		drClient, err := v1alpha3.NewDestinationRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = drClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		vsClient, err := v1alpha3.NewVirtualServiceClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = vsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		rrClient, err := v1.NewRoutingRuleClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = rrClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s := NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			v1alpha3.NewDestinationRuleReconciler(drClient),
+			v1alpha3.NewVirtualServiceReconciler(vsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+
+		mesh := &core.ResourceRef{Name: "name", Namespace: namespace}
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:   core.Metadata{Name: "selectors", Namespace: namespace},
+				TargetMesh: mesh,
+				SourceSelector: &v1.UpstreamSelector{
+					Labels: map[string]string{"app": "productpage"},
+				},
+				DestinationSelector: &v1.UpstreamSelector{
+					Services: []*core.ResourceRef{{Name: "reviews", Namespace: "default"}},
+				},
+				Timeout: &types.Duration{Seconds: 1},
+			},
+			{
+				Metadata:   core.Metadata{Name: "no-match", Namespace: namespace},
+				TargetMesh: mesh,
+				DestinationSelector: &v1.UpstreamSelector{
+					Namespaces: []string{"other"},
+				},
+				Timeout: &types.Duration{Seconds: 2},
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		kubeUpstream := func(name, service string, selector map[string]string) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: name, Namespace: namespace},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{
+							ServiceName:      service,
+							ServiceNamespace: "default",
+							ServicePort:      9080,
+							Selector:         selector,
+						},
+					},
+				},
+			}
+		}
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+				}},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"": {
+					kubeUpstream("default-productpage-9080", "productpage", map[string]string{"app": "productpage"}),
+					kubeUpstream("default-reviews-9080-version-v1", "reviews", map[string]string{"app": "reviews", "version": "v1"}),
+					kubeUpstream("default-reviews-9080-version-v2", "reviews", map[string]string{"app": "reviews", "version": "v2"}),
+				},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{"": rules},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		rule, err := rrClient.Read(namespace, "selectors", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule.Status.State).To(Equal(core.Status_Accepted))
+		Expect(rule.Sources).To(BeEmpty())
+		Expect(rule.Destinations).To(BeEmpty())
+		Expect(rule.SourceSelector).NotTo(BeNil())
+
+		rule, err = rrClient.Read(namespace, "no-match", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rule.Status.State).To(Equal(core.Status_Rejected))
+		Expect(rule.Status.Reason).To(ContainSubstring("invalid destination selector: no upstreams match"))
+
+		// only the rule with the selectors applies, and only to the host of the selected service
+		vs, err := vsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Hosts).To(Equal([]string{"reviews.default.svc.cluster.local"}))
+		Expect(vs[0].Http).To(HaveLen(1))
+		Expect(vs[0].Http[0].Match).To(HaveLen(1))
+		Expect(vs[0].Http[0].Match[0].SourceLabels).To(Equal(map[string]string{"app": "productpage"}))
+		Expect(vs[0].Http[0].Timeout).To(Equal(&types.Duration{Seconds: 1}))
+	})
+
+	It("translates the rules without writing them", func() {
+		upstream := &gloov1.Upstream{
+			Metadata: core.Metadata{Name: "default-reviews-9080", Namespace: namespace},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{
+						ServiceName:      "reviews",
+						ServiceNamespace: "default",
+						ServicePort:      9080,
+					},
+				},
+			},
+		}
+		rule := &v1.RoutingRule{
+			Metadata:     core.Metadata{Name: "timeout", Namespace: namespace},
+			TargetMesh:   &core.ResourceRef{Name: "name", Namespace: namespace},
+			Destinations: []*core.ResourceRef{{Name: upstream.Metadata.Name, Namespace: namespace}},
+			Timeout:      types.DurationProto(time.Second),
+		}
+		invalidRule := &v1.RoutingRule{
+			Metadata: core.Metadata{Name: "no-mesh", Namespace: namespace},
+		}
+		resourceErrs := make(reporter.ResourceErrors)
+		dr, vs, err := TranslateRoutingRules(&v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"": {{
+					Metadata: core.Metadata{Name: "name", Namespace: namespace},
+					MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+				}},
+			},
+			Upstreams:    map[string]gloov1.UpstreamList{"": {upstream}},
+			Routingrules: map[string]v1.RoutingRuleList{"": {rule, invalidRule}},
+		}, map[string]string{"preview": "true"}, resourceErrs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resourceErrs[rule]).NotTo(HaveOccurred())
+		Expect(resourceErrs[invalidRule]).To(MatchError(ContainSubstring("target_mesh required")))
+
+		Expect(dr).To(HaveLen(1))
+		Expect(dr[0].Host).To(Equal("reviews.default.svc.cluster.local"))
+		Expect(vs).To(HaveLen(1))
+		Expect(vs[0].Metadata.Labels).To(Equal(map[string]string{"preview": "true"}))
+		Expect(vs[0].Metadata.Annotations).To(Equal(map[string]string{"created_by": "supergloo"}))
+		Expect(vs[0].Http).To(HaveLen(1))
+		Expect(vs[0].Http[0].Timeout).To(Equal(types.DurationProto(time.Second)))
+	})
+
+	table.DescribeTable("converts the weights of traffic shifting destinations to percentages",
+		func(destinationWeights []uint32, expected []int32) {
+			var upstreams gloov1.UpstreamList
+			var destinations []*v1.WeightedDestination
+			for i, weight := range destinationWeights {
+				version := fmt.Sprintf("v%v", i+1)
+				upstreams = append(upstreams, &gloov1.Upstream{
+					Metadata: core.Metadata{Name: "reviews-" + version, Namespace: namespace},
+					UpstreamSpec: &gloov1.UpstreamSpec{
+						UpstreamType: &gloov1.UpstreamSpec_Kube{
+							Kube: &kubernetes.UpstreamSpec{
+								ServiceName:      "reviews",
+								ServiceNamespace: "default",
+								ServicePort:      9080,
+								Selector:         map[string]string{"app": "reviews", "version": version},
+							},
+						},
+					},
+				})
+				destinations = append(destinations, &v1.WeightedDestination{
+					Upstream: &core.ResourceRef{Name: "reviews-" + version, Namespace: namespace},
+					Weight:   weight,
+				})
+			}
+			rule := &v1.RoutingRule{
+				Metadata:        core.Metadata{Name: "reviews", Namespace: namespace},
+				TargetMesh:      &core.ResourceRef{Name: "name", Namespace: namespace},
+				Destinations:    []*core.ResourceRef{{Name: "reviews-v1", Namespace: namespace}},
+				TrafficShifting: &v1.TrafficShifting{Destinations: destinations},
+			}
+			resourceErrs := make(reporter.ResourceErrors)
+			_, vs, err := TranslateRoutingRules(&v1.TranslatorSnapshot{
+				Meshes: map[string]v1.MeshList{
+					"": {{
+						Metadata: core.Metadata{Name: "name", Namespace: namespace},
+						MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+					}},
+				},
+				Upstreams:    map[string]gloov1.UpstreamList{"": upstreams},
+				Routingrules: map[string]v1.RoutingRuleList{"": {rule}},
+			}, nil, resourceErrs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceErrs[rule]).NotTo(HaveOccurred())
+			Expect(vs).To(HaveLen(1))
+			Expect(vs[0].Http).To(HaveLen(1))
+			var weights []int32
+			for _, dest := range vs[0].Http[0].Route {
+				weights = append(weights, dest.Weight)
+			}
+			Expect(weights).To(Equal(expected))
+		},
+		table.Entry("weights adding up to 100", []uint32{90, 10}, []int32{90, 10}),
+		table.Entry("weights not adding up to 100", []uint32{1, 2}, []int32{33, 67}),
+		table.Entry("the remainder goes to the last destination", []uint32{1, 1, 1}, []int32{33, 33, 34}),
+		// istio sends all the traffic to a single destination
+		table.Entry("a single destination", []uint32{5}, []int32{0}),
+		table.Entry("zero weights", []uint32{0, 0}, []int32{0, 0}),
+	)
 })
@@ -1,7 +1,10 @@
 package kube
 
 import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/errors"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	glookubev1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
 	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
@@ -20,3 +23,52 @@ func RestartPods(kube kubernetes.Interface, namespace string, selector map[strin
 	}
 	return nil
 }
+
+// KubeUpstream returns the spec of the kubernetes upstream, or nil if it is not a kubernetes upstream
+func KubeUpstream(upstreams gloov1.UpstreamList, ref core.ResourceRef) *glookubev1.UpstreamSpec {
+	upstream, err := upstreams.Find(ref.Namespace, ref.Name)
+	if err != nil {
+		return nil
+	}
+	kubeupstream, ok := upstream.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Kube)
+	if !ok {
+		return nil
+	}
+	return kubeupstream.Kube
+}
+
+// ServiceAccount returns the service account of the pods of the upstream's service, or nil if it cannot be found.
+// the meshes manage identity at the level of service accounts, so the policies of every mesh
+// resolve the sources of their rules with this
+func ServiceAccount(kube kubernetes.Interface, upstream *glookubev1.UpstreamSpec) *core.ResourceRef {
+	// so we hueristicly figure out the service account for this upstream.
+	// we may consider changing our API in the future to better support this usecase
+
+	svcname := upstream.ServiceName
+	svcnamespace := upstream.ServiceNamespace
+
+	// find the services and get the selectors, and
+	svc, err := kube.CoreV1().Services(svcnamespace).Get(svcname, kubemeta.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	// get the pods from the selector
+	// get the first pod and grab its service account
+	opts := kubemeta.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+		Limit:         1,
+	}
+	pods, err := kube.CoreV1().Pods(svcnamespace).List(opts)
+	if err != nil {
+		return nil
+	}
+
+	if len(pods.Items) == 0 {
+		return nil
+	}
+	saname := pods.Items[0].Spec.ServiceAccountName
+	return &core.ResourceRef{
+		Name:      saname,
+		Namespace: svcnamespace,
+	}
+}
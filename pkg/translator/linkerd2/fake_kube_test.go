@@ -0,0 +1,94 @@
+package linkerd2_test
+
+import (
+	kubecore "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// in-memory stand-in for the kube clientset, serving the services, pods and namespaces read by the policy syncer.
+// the other methods of the clientset are left unimplemented
+type fakeKube struct {
+	kubeclient.Interface
+	services []kubecore.Service
+	pods     []kubecore.Pod
+}
+
+func (k *fakeKube) CoreV1() corev1.CoreV1Interface {
+	return &fakeCoreV1{kube: k}
+}
+
+type fakeCoreV1 struct {
+	corev1.CoreV1Interface
+	kube *fakeKube
+}
+
+func (c *fakeCoreV1) Services(namespace string) corev1.ServiceInterface {
+	return &fakeServices{kube: c.kube, namespace: namespace}
+}
+
+func (c *fakeCoreV1) Pods(namespace string) corev1.PodInterface {
+	return &fakePods{kube: c.kube, namespace: namespace}
+}
+
+func (c *fakeCoreV1) Namespaces() corev1.NamespaceInterface {
+	return &fakeNamespaces{kube: c.kube}
+}
+
+type fakeServices struct {
+	corev1.ServiceInterface
+	kube      *fakeKube
+	namespace string
+}
+
+func (s *fakeServices) Get(name string, options kubemeta.GetOptions) (*kubecore.Service, error) {
+	for _, svc := range s.kube.services {
+		if svc.Namespace == s.namespace && svc.Name == name {
+			return svc.DeepCopy(), nil
+		}
+	}
+	return nil, kubeerrors.NewNotFound(schema.GroupResource{Resource: "services"}, name)
+}
+
+type fakePods struct {
+	corev1.PodInterface
+	kube      *fakeKube
+	namespace string
+}
+
+func (p *fakePods) List(opts kubemeta.ListOptions) (*kubecore.PodList, error) {
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	list := &kubecore.PodList{}
+	for _, pod := range p.kube.pods {
+		if pod.Namespace == p.namespace && selector.Matches(labels.Set(pod.Labels)) {
+			list.Items = append(list.Items, pod)
+		}
+	}
+	return list, nil
+}
+
+type fakeNamespaces struct {
+	corev1.NamespaceInterface
+	kube *fakeKube
+}
+
+// the namespaces of the services
+func (n *fakeNamespaces) List(opts kubemeta.ListOptions) (*kubecore.NamespaceList, error) {
+	list := &kubecore.NamespaceList{}
+	seen := make(map[string]bool)
+	for _, svc := range n.kube.services {
+		if seen[svc.Namespace] {
+			continue
+		}
+		seen[svc.Namespace] = true
+		list.Items = append(list.Items, kubecore.Namespace{ObjectMeta: kubemeta.ObjectMeta{Name: svc.Namespace}})
+	}
+	return list, nil
+}
@@ -0,0 +1,605 @@
+package linkerd2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/contextutils"
+	"go.uber.org/multierr"
+
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	linkerdv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/v1alpha1"
+	splitv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/smi/split/v1alpha1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
+)
+
+// MeshRoutingSyncer translates routing rules which target a linkerd2 mesh
+// into ServiceProfiles (timeouts, retries) and TrafficSplits (traffic shifting)
+type MeshRoutingSyncer struct {
+	// only read/write crds from these namespaces
+	// needed so we can clean up hanging crds
+	writeNamespaces []string
+	// for reconciling only our resources
+	// override write selector to prevent conflicts between multiple routing syncers
+	// else leave nil
+	writeSelector          map[string]string
+	serviceProfileClient   ServiceProfileClient
+	trafficSplitReconciler splitv1alpha1.TrafficSplitReconciler
+	reporter               reporter.Reporter
+}
+
+func NewMeshRoutingSyncer(writeNamespaces []string,
+	writeSelector map[string]string, // for reconciling only our resources
+	serviceProfileClient ServiceProfileClient,
+	trafficSplitReconciler splitv1alpha1.TrafficSplitReconciler,
+	reporter reporter.Reporter) *MeshRoutingSyncer {
+	if writeSelector == nil {
+		writeSelector = map[string]string{"reconciler.solo.io": "supergloo.linkerd2.routing"}
+	}
+	return &MeshRoutingSyncer{
+		writeNamespaces:        writeNamespaces,
+		writeSelector:          writeSelector,
+		serviceProfileClient:   serviceProfileClient,
+		trafficSplitReconciler: trafficSplitReconciler,
+		reporter:               reporter,
+	}
+}
+
+// linkerd2 looks up service profiles by the fully qualified name of the service,
+// so unlike istio crds the names are written as-is
+func updateMetadataForWriting(meta *core.Metadata, writeSelector map[string]string) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations["created_by"] = "supergloo"
+	if meta.Labels == nil && len(writeSelector) > 0 {
+		meta.Labels = make(map[string]string)
+	}
+	for k, v := range writeSelector {
+		meta.Labels[k] = v
+	}
+}
+
+func (s *MeshRoutingSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "linkerd2-routing-syncer")
+	logger := contextutils.LoggerFrom(ctx)
+	meshes := snap.Meshes.List()
+	upstreams := snap.Upstreams.List()
+	rules := snap.Routingrules.List()
+
+	logger.Infof("begin sync %v (%v meshes, %v upstreams, %v rules)", snap.Hash(),
+		len(meshes), len(upstreams), len(rules))
+	defer logger.Infof("end sync %v", snap.Hash())
+	logger.Debugf("%v", snap)
+
+	// invalid rules are reported and left out of the translation.
+	// the rules are translated with their upstream selectors expanded
+	resourceErrs := make(reporter.ResourceErrors)
+	linkerdRules, expandedFrom := validateRules(rules, meshes, upstreams, resourceErrs)
+	for _, rule := range linkerdRules {
+		warnApproximatedFeatures(ctx, rule)
+	}
+	// the errors of the expanded rules are reported on the rules they were expanded from
+	translationErrs := make(reporter.ResourceErrors)
+
+	var (
+		serviceProfiles []*ServiceProfile
+		trafficSplits   splitv1alpha1.TrafficSplitList
+	)
+	for _, mesh := range meshes {
+		meshRules := shared.RulesForMesh(linkerdRules, mesh)
+		if len(meshRules) == 0 {
+			continue
+		}
+		serviceProfiles = append(serviceProfiles, serviceProfilesForRules(meshRules, upstreams, translationErrs)...)
+		trafficSplits = append(trafficSplits, trafficSplitsForRules(meshRules, upstreams, translationErrs)...)
+	}
+	for rule, err := range translationErrs {
+		resourceErrs.AddError(expandedFrom[rule.(*v1.RoutingRule)], err)
+	}
+	for _, res := range serviceProfiles {
+		updateMetadataForWriting(&res.Metadata, s.writeSelector)
+	}
+	for _, res := range trafficSplits {
+		updateMetadataForWriting(&res.Metadata, s.writeSelector)
+	}
+	writeErr := s.writeLinkerdCrds(ctx, serviceProfiles, trafficSplits)
+	if s.reporter != nil {
+		if err := s.reporter.WriteReports(ctx, resourceErrs, nil); err != nil {
+			writeErr = multierr.Append(writeErr, errors.Wrapf(err, "writing reports"))
+		}
+	}
+	return writeErr
+}
+
+func getLinkerdMeshForRule(rule *v1.RoutingRule, meshes v1.MeshList) (*v1.Linkerd2, error) {
+	if rule.TargetMesh == nil {
+		return nil, errors.Errorf("target_mesh required")
+	}
+	mesh, err := meshes.Find(rule.TargetMesh.Namespace, rule.TargetMesh.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding target mesh %v", rule.TargetMesh)
+	}
+	linkerdMesh, ok := mesh.MeshType.(*v1.Mesh_Linkerd2)
+	if !ok {
+		// not our mesh, we don't care
+		return nil, nil
+	}
+	if linkerdMesh.Linkerd2 == nil {
+		return nil, errors.Errorf("target linkerd2 mesh is invalid")
+	}
+	return linkerdMesh.Linkerd2, nil
+}
+
+// returns the valid rules which target a linkerd2 mesh, with their upstream selectors expanded,
+// along with the rule of the snapshot each of them was expanded from.
+// the rules without a valid target mesh are reported by the istio routing syncer
+func validateRules(rules v1.RoutingRuleList, meshes v1.MeshList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) (v1.RoutingRuleList, map[*v1.RoutingRule]*v1.RoutingRule) {
+	var linkerdRules v1.RoutingRuleList
+	expandedFrom := make(map[*v1.RoutingRule]*v1.RoutingRule)
+	for _, rule := range rules {
+		linkerdMesh, err := getLinkerdMeshForRule(rule, meshes)
+		if err != nil || linkerdMesh == nil {
+			continue
+		}
+		expanded, err := shared.ExpandUpstreamSelectors(rule, upstreams)
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		if err := unsupportedFeatures(expanded); err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		resourceErrs.Accept(rule)
+		linkerdRules = append(linkerdRules, expanded)
+		expandedFrom[expanded] = rule
+	}
+	return linkerdRules, expandedFrom
+}
+
+// linkerd2 has no equivalent for these features, the rules which use them are rejected
+// rather than applied without them
+func unsupportedFeatures(rule *v1.RoutingRule) error {
+	var features []string
+	if len(rule.Sources) > 0 {
+		features = append(features, "sources")
+	}
+	for _, match := range rule.RequestMatchers {
+		if len(match.Headers) > 0 {
+			features = append(features, "header matching")
+			break
+		}
+	}
+	if rule.FaultInjection != nil {
+		features = append(features, "fault injection")
+	}
+	if rule.CorsPolicy != nil {
+		features = append(features, "cors policy")
+	}
+	if rule.Mirror != nil {
+		features = append(features, "mirror")
+	}
+	if rule.HeaderManipulaition != nil {
+		features = append(features, "header manipulation")
+	}
+	// traffic splits apply to tcp connections as well, but they cannot be matched
+	if rule.TcpRouting != nil && len(rule.TcpRouting.Matchers) > 0 {
+		features = append(features, "tcp matchers")
+	}
+	if rule.TlsRouting != nil {
+		features = append(features, "tls matchers")
+	}
+	if rule.TrafficPolicy != nil {
+		features = append(features, "traffic policy")
+	}
+	if len(features) == 0 {
+		return nil
+	}
+	return errors.Errorf("%v not supported by linkerd2", strings.Join(features, ", "))
+}
+
+// linkerd2 applies these features differently, the rest of the rule is applied as is
+func warnApproximatedFeatures(ctx context.Context, rule *v1.RoutingRule) {
+	if rule.Retries != nil && (rule.Retries.Attempts > 0 || rule.Retries.PerTryTimeout != nil) {
+		contextutils.LoggerFrom(ctx).Warnf("routing rule %v: retry attempts and per-try timeout are not supported by linkerd2 "+
+			"and will be ignored (retries are limited by the service's retry budget)", rule.Metadata.Ref())
+	}
+}
+
+// only kubernetes upstreams can be mapped to linkerd2 services
+func kubeSpecForUpstream(us *gloov1.Upstream) (*kubernetes.UpstreamSpec, error) {
+	if us.UpstreamSpec != nil {
+		if kube, ok := us.UpstreamSpec.UpstreamType.(*gloov1.UpstreamSpec_Kube); ok && kube.Kube != nil {
+			return kube.Kube, nil
+		}
+	}
+	return nil, errors.Errorf("upstream %v is not a kubernetes upstream", us.Metadata.Ref())
+}
+
+func serviceFqdn(kube *kubernetes.UpstreamSpec) string {
+	return fmt.Sprintf("%v.%v.svc.cluster.local", kube.ServiceName, kube.ServiceNamespace)
+}
+
+// returns the kube services selected by the rule's destinations, one per unique service
+func servicesForRule(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) ([]*kubernetes.UpstreamSpec, error) {
+	var destinationUpstreams gloov1.UpstreamList
+	if len(rule.Destinations) == 0 {
+		// every kube upstream is a valid destination
+		for _, us := range upstreams {
+			if _, err := kubeSpecForUpstream(us); err == nil {
+				destinationUpstreams = append(destinationUpstreams, us)
+			}
+		}
+	}
+	for _, dest := range rule.Destinations {
+		us, err := upstreams.Find(dest.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination for rule %v", dest)
+		}
+		destinationUpstreams = append(destinationUpstreams, us)
+	}
+
+	var services []*kubernetes.UpstreamSpec
+addUniqueServices:
+	for _, us := range destinationUpstreams {
+		kube, err := kubeSpecForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination for rule %v", rule.Metadata.Ref())
+		}
+		for _, added := range services {
+			if serviceFqdn(added) == serviceFqdn(kube) {
+				continue addUniqueServices
+			}
+		}
+		services = append(services, kube)
+	}
+	return services, nil
+}
+
+// service profiles
+// the rules which cannot be translated are left out and their errors are added to resourceErrs
+func serviceProfilesForRules(rules v1.RoutingRuleList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) []*ServiceProfile {
+	profilesByService := make(map[string]*ServiceProfile)
+	for _, rule := range rules {
+		// service profiles only carry timeouts and retries
+		if rule.Timeout == nil && rule.Retries == nil {
+			continue
+		}
+		routes, err := routesForRule(rule)
+		if err != nil {
+			resourceErrs.AddError(rule, errors.Wrapf(err, "creating routes"))
+			continue
+		}
+		services, err := servicesForRule(rule, upstreams)
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		// the routes of the rule are added to copies of the profiles,
+		// so that a rule which conflicts with the previous ones is left out entirely
+		updated := make(map[string]*ServiceProfile)
+		for _, svc := range services {
+			fqdn := serviceFqdn(svc)
+			profile, ok := profilesByService[fqdn]
+			if ok {
+				profile = &ServiceProfile{
+					Metadata: profile.Metadata,
+					Spec:     proto.Clone(profile.Spec).(*linkerdv1alpha1.ServiceProfileSpec),
+				}
+			} else {
+				profile = &ServiceProfile{
+					Metadata: core.Metadata{
+						Name:      fqdn,
+						Namespace: svc.ServiceNamespace,
+					},
+					Spec: &linkerdv1alpha1.ServiceProfileSpec{},
+				}
+			}
+			for _, route := range routes {
+				if err = addRoute(profile, route); err != nil {
+					err = errors.Wrapf(err, "incompatible with service profile for %v", fqdn)
+					break
+				}
+			}
+			if err != nil {
+				break
+			}
+			updated[fqdn] = profile
+		}
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		for fqdn, profile := range updated {
+			profilesByService[fqdn] = profile
+		}
+	}
+	var serviceProfiles []*ServiceProfile
+	for _, profile := range profilesByService {
+		serviceProfiles = append(serviceProfiles, profile)
+	}
+	sort.SliceStable(serviceProfiles, func(i, j int) bool {
+		return serviceProfiles[i].Metadata.Less(serviceProfiles[j].Metadata)
+	})
+	return serviceProfiles
+}
+
+// each request matcher on the rule gets its own route
+func routesForRule(rule *v1.RoutingRule) ([]*linkerdv1alpha1.RouteSpec, error) {
+	var timeout string
+	if rule.Timeout != nil {
+		duration, err := types.DurationFromProto(rule.Timeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout")
+		}
+		timeout = duration.String()
+	}
+	isRetryable := rule.Retries != nil
+
+	matchers := rule.RequestMatchers
+	if len(matchers) == 0 {
+		// default, catch-all matcher
+		matchers = []*gloov1.Matcher{{
+			PathSpecifier: &gloov1.Matcher_Prefix{
+				Prefix: "/",
+			},
+		}}
+	}
+	var routes []*linkerdv1alpha1.RouteSpec
+	for i, match := range matchers {
+		name := fmt.Sprintf("%v.%v", rule.Metadata.Namespace, rule.Metadata.Name)
+		if len(matchers) > 1 {
+			name = fmt.Sprintf("%v-%v", name, i)
+		}
+		routes = append(routes, &linkerdv1alpha1.RouteSpec{
+			Name:        name,
+			Condition:   convertMatcher(match),
+			IsRetryable: isRetryable,
+			Timeout:     timeout,
+		})
+	}
+	return routes, nil
+}
+
+func convertMatcher(match *gloov1.Matcher) *linkerdv1alpha1.RequestMatch {
+	var conditions []*linkerdv1alpha1.RequestMatch
+	var pathRegex string
+	switch path := match.PathSpecifier.(type) {
+	case *gloov1.Matcher_Exact:
+		pathRegex = regexp.QuoteMeta(path.Exact)
+	case *gloov1.Matcher_Regex:
+		pathRegex = path.Regex
+	case *gloov1.Matcher_Prefix:
+		pathRegex = regexp.QuoteMeta(path.Prefix) + ".*"
+	}
+	if pathRegex != "" {
+		conditions = append(conditions, &linkerdv1alpha1.RequestMatch{PathRegex: pathRegex})
+	}
+	switch len(match.Methods) {
+	case 0:
+	case 1:
+		conditions = append(conditions, &linkerdv1alpha1.RequestMatch{Method: match.Methods[0]})
+	default:
+		var methods []*linkerdv1alpha1.RequestMatch
+		for _, method := range match.Methods {
+			methods = append(methods, &linkerdv1alpha1.RequestMatch{Method: method})
+		}
+		conditions = append(conditions, &linkerdv1alpha1.RequestMatch{Any: methods})
+	}
+	switch len(conditions) {
+	case 0:
+		return &linkerdv1alpha1.RequestMatch{PathRegex: ".*"}
+	case 1:
+		return conditions[0]
+	}
+	return &linkerdv1alpha1.RequestMatch{All: conditions}
+}
+
+// routes with the same condition are merged into one, as linkerd2 only applies the first matching route
+func addRoute(profile *ServiceProfile, route *linkerdv1alpha1.RouteSpec) error {
+	for _, existing := range profile.Spec.Routes {
+		if !existing.Condition.Equal(route.Condition) {
+			continue
+		}
+		if route.Timeout != "" {
+			if existing.Timeout != "" {
+				return errors.Errorf("Timeout redefined for route %v", existing.Name)
+			}
+			existing.Timeout = route.Timeout
+		}
+		if route.IsRetryable {
+			if existing.IsRetryable {
+				return errors.Errorf("Retries redefined for route %v", existing.Name)
+			}
+			existing.IsRetryable = true
+		}
+		return nil
+	}
+	profile.Spec.Routes = append(profile.Spec.Routes, route)
+	return nil
+}
+
+// traffic splits
+// the rules which cannot be translated are left out and their errors are added to resourceErrs
+func trafficSplitsForRules(rules v1.RoutingRuleList, upstreams gloov1.UpstreamList, resourceErrs reporter.ResourceErrors) splitv1alpha1.TrafficSplitList {
+	splitsByService := make(map[string]*splitv1alpha1.TrafficSplit)
+	var trafficSplits splitv1alpha1.TrafficSplitList
+	for _, rule := range rules {
+		if rule.TrafficShifting == nil || len(rule.TrafficShifting.Destinations) == 0 {
+			continue
+		}
+		ruleSplits, err := trafficSplitsForRule(rule, upstreams, splitsByService)
+		if err != nil {
+			resourceErrs.AddError(rule, err)
+			continue
+		}
+		for fqdn, split := range ruleSplits {
+			splitsByService[fqdn] = split
+			trafficSplits = append(trafficSplits, split)
+		}
+	}
+	return trafficSplits.Sort()
+}
+
+// the traffic splits of the rule, keyed by the fully qualified name of their service
+func trafficSplitsForRule(rule *v1.RoutingRule, upstreams gloov1.UpstreamList, splitsByService map[string]*splitv1alpha1.TrafficSplit) (map[string]*splitv1alpha1.TrafficSplit, error) {
+	// without explicit destinations every backend would be split to itself
+	if len(rule.Destinations) == 0 {
+		return nil, errors.Errorf("traffic shifting on linkerd2 requires at least one destination")
+	}
+	services, err := servicesForRule(rule, upstreams)
+	if err != nil {
+		return nil, err
+	}
+	splits := make(map[string]*splitv1alpha1.TrafficSplit)
+	for _, svc := range services {
+		fqdn := serviceFqdn(svc)
+		if _, ok := splitsByService[fqdn]; ok {
+			return nil, errors.Errorf("TrafficShifting redefined for service %v", fqdn)
+		}
+		backends, err := createBackends(svc, rule.TrafficShifting.Destinations, upstreams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating traffic split")
+		}
+		splits[fqdn] = &splitv1alpha1.TrafficSplit{
+			Metadata: core.Metadata{
+				Name:      svc.ServiceName,
+				Namespace: svc.ServiceNamespace,
+			},
+			Service:  svc.ServiceName,
+			Backends: backends,
+		}
+	}
+	return splits, nil
+}
+
+// linkerd2 splits traffic across services rather than pod subsets,
+// so each weighted destination must resolve to a service in the root service's namespace
+func createBackends(root *kubernetes.UpstreamSpec, destinations []*v1.WeightedDestination, upstreams gloov1.UpstreamList) ([]*splitv1alpha1.TrafficSplitBackend, error) {
+	var backends []*splitv1alpha1.TrafficSplitBackend
+addBackends:
+	for _, dest := range destinations {
+		if dest.Upstream == nil {
+			return nil, errors.Errorf("weighted destination must specify an upstream")
+		}
+		us, err := upstreams.Find(dest.Upstream.Strings())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination %v", dest)
+		}
+		kube, err := kubeSpecForUpstream(us)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination %v", dest)
+		}
+		if kube.ServiceNamespace != root.ServiceNamespace {
+			return nil, errors.Errorf("destination %v must be in the same namespace as service %v", dest.Upstream, serviceFqdn(root))
+		}
+		for _, added := range backends {
+			if added.Service == kube.ServiceName {
+				added.Weight += dest.Weight
+				continue addBackends
+			}
+		}
+		backends = append(backends, &splitv1alpha1.TrafficSplitBackend{
+			Service: kube.ServiceName,
+			Weight:  dest.Weight,
+		})
+	}
+	return backends, nil
+}
+
+// util functions
+func (s *MeshRoutingSyncer) writeLinkerdCrds(ctx context.Context, serviceProfiles []*ServiceProfile, trafficSplits splitv1alpha1.TrafficSplitList) error {
+	opts := clients.ListOpts{
+		Ctx:      ctx,
+		Selector: s.writeSelector,
+	}
+	var errs error
+	contextutils.LoggerFrom(ctx).Infof("reconciling %v service profiles", len(serviceProfiles))
+	spByNamespace := make(map[string][]*ServiceProfile)
+	for _, sp := range serviceProfiles {
+		spByNamespace[sp.Metadata.Namespace] = append(spByNamespace[sp.Metadata.Namespace], sp)
+	}
+	for _, ns := range s.writeNamespaces {
+		if err := s.reconcileServiceProfiles(ns, spByNamespace[ns]); err != nil {
+			return errors.Wrapf(err, "reconciling service profiles")
+		}
+		delete(spByNamespace, ns)
+	}
+	for ns, serviceProfiles := range spByNamespace {
+		errs = multierr.Append(errs, errors.Errorf("internal error: "+
+			"%v service profiles were generated for namespace %v, but only "+
+			"%v are available namespaces for writing", len(serviceProfiles), ns, s.writeNamespaces))
+	}
+
+	contextutils.LoggerFrom(ctx).Infof("reconciling %v traffic splits", len(trafficSplits))
+	tsByNamespace := make(splitv1alpha1.TrafficsplitsByNamespace)
+	tsByNamespace.Add(trafficSplits...)
+	for _, ns := range s.writeNamespaces {
+		if err := s.trafficSplitReconciler.Reconcile(ns, tsByNamespace[ns], preserveTrafficSplit, opts); err != nil {
+			return errors.Wrapf(err, "reconciling traffic splits")
+		}
+		delete(tsByNamespace, ns)
+	}
+	for ns, trafficSplits := range tsByNamespace {
+		errs = multierr.Append(errs, errors.Errorf("internal error: "+
+			"%v traffic splits were generated for namespace %v, but only "+
+			"%v are available namespaces for writing", len(trafficSplits), ns, s.writeNamespaces))
+	}
+	return errs
+}
+
+// service profiles have no generated reconciler, see ServiceProfile
+func (s *MeshRoutingSyncer) reconcileServiceProfiles(namespace string, desiredProfiles []*ServiceProfile) error {
+	originalProfiles, err := s.serviceProfileClient.List(namespace, s.writeSelector)
+	if err != nil {
+		return err
+	}
+	for _, original := range originalProfiles {
+		var desired bool
+		for _, sp := range desiredProfiles {
+			if sp.Metadata.Name == original.Metadata.Name {
+				desired = true
+				break
+			}
+		}
+		if !desired {
+			if err := s.serviceProfileClient.Delete(namespace, original.Metadata.Name); err != nil {
+				return err
+			}
+		}
+	}
+writeDesired:
+	for _, desired := range desiredProfiles {
+		for _, original := range originalProfiles {
+			if original.Metadata.Name == desired.Metadata.Name &&
+				proto.Equal(original.Spec, desired.Spec) &&
+				reflect.DeepEqual(original.Metadata.Labels, desired.Metadata.Labels) &&
+				reflect.DeepEqual(original.Metadata.Annotations, desired.Metadata.Annotations) {
+				continue writeDesired
+			}
+		}
+		if err := s.serviceProfileClient.Write(desired); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func preserveTrafficSplit(original, desired *splitv1alpha1.TrafficSplit) (bool, error) {
+	original.Metadata = desired.Metadata
+	original.Status = desired.Status
+	return !proto.Equal(original, desired), nil
+}
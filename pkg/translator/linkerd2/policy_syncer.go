@@ -0,0 +1,288 @@
+package linkerd2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/contextutils"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	policyv1beta1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/policy/v1beta1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	kubeutils "github.com/solo-io/supergloo/pkg/translator/kube"
+	"github.com/solo-io/supergloo/pkg/translator/shared"
+	kubecore "k8s.io/api/core/v1"
+	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+)
+
+// PolicySyncer enforces the policies of linkerd2 meshes with Servers and ServerAuthorizations.
+// Once the port of a destination is selected by a Server, linkerd2 only allows the connections to it
+// from the service accounts of the sources of the policy rules
+type PolicySyncer struct {
+	// for reconciling only our resources
+	writeSelector                 map[string]string
+	kubeClient                    kubeclient.Interface
+	serverReconciler              policyv1beta1.ServerReconciler
+	serverAuthorizationReconciler policyv1beta1.ServerAuthorizationReconciler
+}
+
+func NewPolicySyncer(writeSelector map[string]string, // for reconciling only our resources
+	kubeClient kubeclient.Interface,
+	serverReconciler policyv1beta1.ServerReconciler,
+	serverAuthorizationReconciler policyv1beta1.ServerAuthorizationReconciler) *PolicySyncer {
+	if writeSelector == nil {
+		writeSelector = map[string]string{"reconciler.solo.io": "supergloo.linkerd2.policy"}
+	}
+	return &PolicySyncer{
+		writeSelector:                 writeSelector,
+		kubeClient:                    kubeClient,
+		serverReconciler:              serverReconciler,
+		serverAuthorizationReconciler: serverAuthorizationReconciler,
+	}
+}
+
+func (s *PolicySyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	ctx = contextutils.WithLogger(ctx, "linkerd2-policy-syncer")
+	logger := contextutils.LoggerFrom(ctx)
+
+	var (
+		linkerdMeshes        v1.MeshList
+		servers              policyv1beta1.ServerList
+		serverAuthorizations policyv1beta1.ServerAuthorizationList
+		multiErr             *multierror.Error
+	)
+	for _, mesh := range snap.Meshes.List() {
+		_, ok := mesh.MeshType.(*v1.Mesh_Linkerd2)
+		if !ok {
+			// not our mesh, we don't care
+			continue
+		}
+		linkerdMeshes = append(linkerdMeshes, mesh)
+		// the rules of the mesh policies targeting the mesh are enforced along with its own
+		policy := shared.MergedPolicy(mesh, snap.Meshpolicies.List(), snap.Upstreams.List())
+		if policy == nil {
+			continue
+		}
+		meshServers, meshServerAuthorizations, err := TranslatePolicy(s.writeSelector, s.kubeClient, snap.Upstreams.List(), policy)
+		if err != nil {
+			// the rest of the policy is still applied
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
+		}
+		servers = append(servers, meshServers...)
+		serverAuthorizations = append(serverAuthorizations, meshServerAuthorizations...)
+	}
+	if len(linkerdMeshes) == 0 {
+		return nil
+	}
+	logger.Infof("writing %v servers and %v server authorizations", len(servers), len(serverAuthorizations))
+
+	// the resources of the meshes without a policy are removed along with the ones which are not desired anymore
+	if err := s.reconcile(ctx, servers, serverAuthorizations); err != nil {
+		for _, mesh := range linkerdMeshes {
+			multiErr = multierror.Append(multiErr, shared.NewMeshSyncError(mesh, err))
+		}
+	}
+	return multiErr.ErrorOrNil()
+}
+
+func (s *PolicySyncer) reconcile(ctx context.Context, servers policyv1beta1.ServerList, serverAuthorizations policyv1beta1.ServerAuthorizationList) error {
+	opts := clients.ListOpts{
+		Ctx:      ctx,
+		Selector: s.writeSelector,
+	}
+
+	// the resources are written to the namespaces of the services they protect
+	namespaces, err := s.kubeClient.CoreV1().Namespaces().List(kubemeta.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces.Items {
+		ns := namespace.Name
+		var nsServers policyv1beta1.ServerList
+		for _, server := range servers {
+			if server.Metadata.Namespace == ns {
+				nsServers = append(nsServers, server)
+			}
+		}
+		var nsServerAuthorizations policyv1beta1.ServerAuthorizationList
+		for _, saz := range serverAuthorizations {
+			if saz.Metadata.Namespace == ns {
+				nsServerAuthorizations = append(nsServerAuthorizations, saz)
+			}
+		}
+
+		if err := s.serverAuthorizationReconciler.Reconcile(ns, nsServerAuthorizations, preserveServerAuthorization, opts); err != nil {
+			return err
+		}
+		if err := s.serverReconciler.Reconcile(ns, nsServers, preserveServer, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TranslatePolicy returns the servers and server authorizations the PolicySyncer writes to enforce the policy
+// of a linkerd2 mesh, with the metadata they are written with. As with istio, the sources of the rules are
+// identified by the service accounts of their pods, which are looked up with the kube client.
+// destinations whose service cannot be found are left out. servers apply to all the requests to their port,
+// so the rules which restrict the requests further are left out as well and returned as an error.
+// servers cannot be put in a dry run mode, so permissive policies are not enforced at all
+func TranslatePolicy(writeSelector map[string]string, kubeClient kubeclient.Interface, upstreams gloov1.UpstreamList, p *v1.Policy) (policyv1beta1.ServerList, policyv1beta1.ServerAuthorizationList, error) {
+	switch p.Mode {
+	case v1.Policy_OFF:
+		return nil, nil, nil
+	case v1.Policy_PERMISSIVE:
+		return nil, nil, shared.UnsupportedPolicyError("permissive policies", "linkerd2")
+	}
+
+	rulesByDest := map[core.ResourceRef][]*v1.Rule{}
+	var dests []core.ResourceRef
+	var unsupported *multierror.Error
+	// as with istio, the requests which are not allowed by a server authorization are denied
+	rules, err := shared.AllowedRules(p, upstreams, "linkerd2")
+	if err != nil {
+		unsupported = multierror.Append(unsupported, err)
+	}
+	for _, rule := range rules {
+		if rule.Source == nil || rule.Destination == nil {
+			continue
+		}
+		if len(shared.RuleRestrictions(rule)) > 0 {
+			unsupported = multierror.Append(unsupported, shared.UnsupportedRestrictionsError(rule, "linkerd2"))
+			continue
+		}
+		if _, ok := rulesByDest[*rule.Destination]; !ok {
+			dests = append(dests, *rule.Destination)
+		}
+		rulesByDest[*rule.Destination] = append(rulesByDest[*rule.Destination], rule)
+	}
+	// sort for idempotency
+	sort.Slice(dests, func(i, j int) bool {
+		return dests[i].Key() < dests[j].Key()
+	})
+
+	var (
+		servers              policyv1beta1.ServerList
+		serverAuthorizations policyv1beta1.ServerAuthorizationList
+	)
+	for _, dest := range dests {
+		destupstream := kubeutils.KubeUpstream(upstreams, dest)
+		if destupstream == nil || !shared.PolicyEnforced(p, destupstream.ServiceNamespace) {
+			continue
+		}
+		server := serverForUpstream(kubeClient, dest, destupstream)
+		if server == nil {
+			continue
+		}
+		updateMetadataForWriting(&server.Metadata, writeSelector)
+		servers = append(servers, server)
+
+		serviceAccounts := map[core.ResourceRef]bool{}
+		var clientAccounts []*policyv1beta1.ServiceAccountName
+		for _, rule := range rulesByDest[dest] {
+			sourceupstream := kubeutils.KubeUpstream(upstreams, *rule.Source)
+			if sourceupstream == nil {
+				continue
+			}
+			sa := kubeutils.ServiceAccount(kubeClient, sourceupstream)
+			if sa == nil || serviceAccounts[*sa] {
+				continue
+			}
+			serviceAccounts[*sa] = true
+			clientAccounts = append(clientAccounts, &policyv1beta1.ServiceAccountName{
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			})
+		}
+		// without an authorization, the server denies all connections
+		if len(clientAccounts) == 0 {
+			continue
+		}
+		sort.Slice(clientAccounts, func(i, j int) bool {
+			if clientAccounts[i].Namespace != clientAccounts[j].Namespace {
+				return clientAccounts[i].Namespace < clientAccounts[j].Namespace
+			}
+			return clientAccounts[i].Name < clientAccounts[j].Name
+		})
+		saz := &policyv1beta1.ServerAuthorization{
+			Metadata: core.Metadata{
+				Name:      "bind-" + dest.Namespace + "-" + dest.Name,
+				Namespace: server.Metadata.Namespace,
+			},
+			Server: &policyv1beta1.ServerTarget{
+				Name: server.Metadata.Name,
+			},
+			Client: &policyv1beta1.Client{
+				MeshTls: &policyv1beta1.MeshTLS{
+					ServiceAccounts: clientAccounts,
+				},
+			},
+		}
+		updateMetadataForWriting(&saz.Metadata, writeSelector)
+		serverAuthorizations = append(serverAuthorizations, saz)
+	}
+	return servers, serverAuthorizations, unsupported.ErrorOrNil()
+}
+
+// the server selects the pods of the upstream's service on the container port the upstream's port targets
+func serverForUpstream(kubeClient kubeclient.Interface, ref core.ResourceRef, upstream *kubernetes.UpstreamSpec) *policyv1beta1.Server {
+	svc, err := kubeClient.CoreV1().Services(upstream.ServiceNamespace).Get(upstream.ServiceName, kubemeta.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	podSelector := make(map[string]string)
+	for k, v := range svc.Spec.Selector {
+		podSelector[k] = v
+	}
+	// upstreams for a subset of the service select its pods further
+	for k, v := range upstream.Selector {
+		podSelector[k] = v
+	}
+	return &policyv1beta1.Server{
+		Metadata: core.Metadata{
+			// the same names as the istio service roles
+			Name:      "access-" + ref.Namespace + "-" + ref.Name,
+			Namespace: upstream.ServiceNamespace,
+		},
+		PodSelector: &policyv1beta1.LabelSelector{
+			MatchLabels: podSelector,
+		},
+		Port: targetPort(svc, upstream.ServicePort),
+	}
+}
+
+// returns the number or the name of the container port the service port targets
+func targetPort(svc *kubecore.Service, servicePort uint32) *types.Value {
+	port := &types.Value{Kind: &types.Value_NumberValue{NumberValue: float64(servicePort)}}
+	for _, p := range svc.Spec.Ports {
+		if uint32(p.Port) != servicePort {
+			continue
+		}
+		switch {
+		case p.TargetPort.StrVal != "":
+			port.Kind = &types.Value_StringValue{StringValue: p.TargetPort.StrVal}
+		case p.TargetPort.IntVal != 0:
+			port.Kind = &types.Value_NumberValue{NumberValue: float64(p.TargetPort.IntVal)}
+		}
+	}
+	return port
+}
+
+func preserveServer(original, desired *policyv1beta1.Server) (bool, error) {
+	original.Metadata = desired.Metadata
+	original.Status = desired.Status
+	return !proto.Equal(original, desired), nil
+}
+
+func preserveServerAuthorization(original, desired *policyv1beta1.ServerAuthorization) (bool, error) {
+	original.Metadata = desired.Metadata
+	original.Status = desired.Status
+	return !proto.Equal(original, desired), nil
+}
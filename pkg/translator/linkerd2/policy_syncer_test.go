@@ -0,0 +1,238 @@
+package linkerd2_test
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	policyv1beta1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/policy/v1beta1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/linkerd2"
+	kubecore "k8s.io/api/core/v1"
+	kubemeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("PolicySyncer", func() {
+	var kube *fakeKube
+
+	service := func(namespace, name string, targetPort intstr.IntOrString) kubecore.Service {
+		return kubecore.Service{
+			ObjectMeta: kubemeta.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: kubecore.ServiceSpec{
+				Selector: map[string]string{"app": name},
+				Ports:    []kubecore.ServicePort{{Port: 9080, TargetPort: targetPort}},
+			},
+		}
+	}
+	pod := func(namespace, app, serviceAccount string) kubecore.Pod {
+		return kubecore.Pod{
+			ObjectMeta: kubemeta.ObjectMeta{Name: app, Namespace: namespace, Labels: map[string]string{"app": app}},
+			Spec:       kubecore.PodSpec{ServiceAccountName: serviceAccount},
+		}
+	}
+	upstream := func(name, namespace, service string, selector map[string]string) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{
+						ServiceName:      service,
+						ServiceNamespace: namespace,
+						ServicePort:      9080,
+						Selector:         selector,
+					},
+				},
+			},
+		}
+	}
+	upstreams := gloov1.UpstreamList{
+		upstream("productpage", "default", "productpage", nil),
+		upstream("productpage-v2", "default", "productpage", map[string]string{"version": "v2"}),
+		upstream("reviews", "default", "reviews", nil),
+		upstream("reviews-v2", "default", "reviews", map[string]string{"version": "v2"}),
+		upstream("ratings", "default", "ratings", nil),
+		upstream("details", "default", "details", nil),
+		upstream("web", "staging", "web", nil),
+	}
+	ref := func(name string) *core.ResourceRef {
+		return &core.ResourceRef{Name: name, Namespace: "gloo-system"}
+	}
+	rule := func(source, destination string) *v1.Rule {
+		return &v1.Rule{Source: ref(source), Destination: ref(destination)}
+	}
+
+	BeforeEach(func() {
+		kube = &fakeKube{
+			services: []kubecore.Service{
+				service("default", "productpage", intstr.IntOrString{}),
+				service("default", "reviews", intstr.FromString("http")),
+				service("default", "ratings", intstr.FromInt(8080)),
+				// no pods
+				service("default", "details", intstr.IntOrString{}),
+				service("staging", "web", intstr.IntOrString{}),
+			},
+			pods: []kubecore.Pod{
+				pod("default", "productpage", "bookinfo-productpage"),
+				pod("default", "reviews", "bookinfo-reviews"),
+				pod("default", "ratings", "bookinfo-ratings"),
+				pod("staging", "web", "web"),
+			},
+		}
+	})
+
+	Describe("TranslatePolicy", func() {
+		It("selects the pods of the destinations on the port their service port targets", func() {
+			servers, _, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Rules: []*v1.Rule{
+					rule("productpage", "reviews-v2"),
+					rule("productpage", "ratings"),
+					rule("reviews", "productpage"),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servers).To(HaveLen(3))
+			Expect(servers[0].Metadata.Name).To(Equal("access-gloo-system-productpage"))
+			Expect(servers[0].Metadata.Namespace).To(Equal("default"))
+			Expect(servers[0].PodSelector.MatchLabels).To(Equal(map[string]string{"app": "productpage"}))
+			// without a target port, the service port is the container port
+			Expect(servers[0].Port).To(Equal(&types.Value{Kind: &types.Value_NumberValue{NumberValue: 9080}}))
+
+			Expect(servers[1].Metadata.Name).To(Equal("access-gloo-system-ratings"))
+			Expect(servers[1].Port).To(Equal(&types.Value{Kind: &types.Value_NumberValue{NumberValue: 8080}}))
+
+			Expect(servers[2].Metadata.Name).To(Equal("access-gloo-system-reviews-v2"))
+			// the upstream selects a subset of the pods of its service
+			Expect(servers[2].PodSelector.MatchLabels).To(Equal(map[string]string{"app": "reviews", "version": "v2"}))
+			Expect(servers[2].Port).To(Equal(&types.Value{Kind: &types.Value_StringValue{StringValue: "http"}}))
+		})
+
+		It("authorizes the service accounts of the sources once, sorted by namespace and name", func() {
+			servers, serverAuthorizations, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Rules: []*v1.Rule{
+					rule("web", "reviews"),
+					rule("productpage-v2", "reviews"),
+					rule("productpage", "reviews"),
+					// the service account of details cannot be found
+					rule("details", "reviews"),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servers).To(HaveLen(1))
+			Expect(serverAuthorizations).To(HaveLen(1))
+			saz := serverAuthorizations[0]
+			Expect(saz.Metadata.Name).To(Equal("bind-gloo-system-reviews"))
+			Expect(saz.Metadata.Namespace).To(Equal("default"))
+			Expect(saz.Metadata.Annotations).To(HaveKeyWithValue("created_by", "supergloo"))
+			Expect(saz.Server).To(Equal(&policyv1beta1.ServerTarget{Name: servers[0].Metadata.Name}))
+			Expect(saz.Client.MeshTls.ServiceAccounts).To(Equal([]*policyv1beta1.ServiceAccountName{
+				{Name: "bookinfo-productpage", Namespace: "default"},
+				{Name: "web", Namespace: "staging"},
+			}))
+		})
+
+		It("writes no server authorization for a destination without any source service account", func() {
+			servers, serverAuthorizations, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Rules: []*v1.Rule{rule("details", "ratings")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			// the server denies all the connections to the destination
+			Expect(servers).To(HaveLen(1))
+			Expect(servers[0].Metadata.Name).To(Equal("access-gloo-system-ratings"))
+			Expect(serverAuthorizations).To(BeEmpty())
+		})
+
+		It("writes nothing for policies which are off", func() {
+			servers, serverAuthorizations, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Mode:  v1.Policy_OFF,
+				Rules: []*v1.Rule{rule("productpage", "reviews")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servers).To(BeEmpty())
+			Expect(serverAuthorizations).To(BeEmpty())
+		})
+
+		It("does not enforce permissive policies", func() {
+			servers, serverAuthorizations, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Mode:  v1.Policy_PERMISSIVE,
+				Rules: []*v1.Rule{rule("productpage", "reviews")},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("permissive policies are not supported by linkerd2"))
+			Expect(servers).To(BeEmpty())
+			Expect(serverAuthorizations).To(BeEmpty())
+		})
+
+		It("reports the restricted rules as unsupported and applies the others", func() {
+			restricted := rule("web", "reviews")
+			restricted.Methods = []string{"GET"}
+			servers, serverAuthorizations, err := TranslatePolicy(nil, kube, upstreams, &v1.Policy{
+				Rules: []*v1.Rule{restricted, rule("productpage", "reviews")},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("policy rule from gloo-system.web to gloo-system.reviews cannot be applied: " +
+				"methods are not supported by linkerd2"))
+			Expect(servers).To(HaveLen(1))
+			Expect(serverAuthorizations).To(HaveLen(1))
+			Expect(serverAuthorizations[0].Client.MeshTls.ServiceAccounts).To(Equal([]*policyv1beta1.ServiceAccountName{
+				{Name: "bookinfo-productpage", Namespace: "default"},
+			}))
+		})
+	})
+
+	It("removes the resources of the meshes which no longer have a policy", func() {
+		rcFactory := &factory.MemoryResourceClientFactory{Cache: memory.NewInMemoryResourceCache()}
+		serverClient, err := policyv1beta1.NewServerClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+		serverAuthorizationClient, err := policyv1beta1.NewServerAuthorizationClient(rcFactory)
+		Expect(err).NotTo(HaveOccurred())
+		s := NewPolicySyncer(nil, kube,
+			policyv1beta1.NewServerReconciler(serverClient),
+			policyv1beta1.NewServerAuthorizationReconciler(serverAuthorizationClient))
+
+		// not written by supergloo
+		_, err = serverClient.Write(&policyv1beta1.Server{
+			Metadata: core.Metadata{Name: "admin", Namespace: "default"},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot := func(policy *v1.Policy) *v1.TranslatorSnapshot {
+			return &v1.TranslatorSnapshot{
+				Meshes: map[string]v1.MeshList{
+					"": {{
+						Metadata: core.Metadata{Name: "linkerd", Namespace: "supergloo-system"},
+						MeshType: &v1.Mesh_Linkerd2{Linkerd2: &v1.Linkerd2{}},
+						Policy:   policy,
+					}},
+				},
+				Upstreams: map[string]gloov1.UpstreamList{"": upstreams},
+			}
+		}
+
+		err = s.Sync(context.TODO(), snapshot(&v1.Policy{Rules: []*v1.Rule{rule("productpage", "reviews")}}))
+		Expect(err).NotTo(HaveOccurred())
+		servers, err := serverClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(servers).To(HaveLen(2))
+		serverAuthorizations, err := serverAuthorizationClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serverAuthorizations).To(HaveLen(1))
+
+		err = s.Sync(context.TODO(), snapshot(nil))
+		Expect(err).NotTo(HaveOccurred())
+		servers, err = serverClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(servers).To(HaveLen(1))
+		Expect(servers[0].Metadata.Name).To(Equal("admin"))
+		serverAuthorizations, err = serverAuthorizationClient.List("default", clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serverAuthorizations).To(BeEmpty())
+	})
+})
@@ -0,0 +1,303 @@
+package linkerd2_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	"github.com/solo-io/supergloo/pkg/api/external/istio/networking/v1alpha3"
+	linkerdv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/v1alpha1"
+	splitv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/smi/split/v1alpha1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/linkerd2"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// in-memory stand-in for the dynamic kube client
+type memoryServiceProfileClient struct {
+	profiles map[string]*ServiceProfile
+}
+
+func (c *memoryServiceProfileClient) List(namespace string, selector map[string]string) ([]*ServiceProfile, error) {
+	var profiles []*ServiceProfile
+	for _, sp := range c.profiles {
+		if sp.Metadata.Namespace == namespace && labels.SelectorFromSet(selector).Matches(labels.Set(sp.Metadata.Labels)) {
+			profiles = append(profiles, sp)
+		}
+	}
+	return profiles, nil
+}
+
+func (c *memoryServiceProfileClient) Write(profile *ServiceProfile) error {
+	c.profiles[profile.Metadata.Namespace+"/"+profile.Metadata.Name] = profile
+	return nil
+}
+
+func (c *memoryServiceProfileClient) Delete(namespace, name string) error {
+	delete(c.profiles, namespace+"/"+name)
+	return nil
+}
+
+var _ = Describe("RoutingSyncer", func() {
+	namespace := "default"
+	var (
+		spClient *memoryServiceProfileClient
+		tsClient splitv1alpha1.TrafficSplitClient
+		s        *MeshRoutingSyncer
+	)
+	upstream := func(name, service string) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{
+				Name:      name,
+				Namespace: "gloo-system",
+			},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{
+						ServiceName:      service,
+						ServiceNamespace: namespace,
+						ServicePort:      9080,
+						Selector:         map[string]string{"app": service},
+					},
+				},
+			},
+		}
+	}
+	snapshot := func(rules ...*v1.RoutingRule) *v1.TranslatorSnapshot {
+		return &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{
+				"ignored-at-this-point": {
+					{
+						Metadata: core.Metadata{Name: "linkerd", Namespace: "supergloo-system"},
+						MeshType: &v1.Mesh_Linkerd2{
+							Linkerd2: &v1.Linkerd2{},
+						},
+					},
+					{
+						Metadata: core.Metadata{Name: "istio", Namespace: "supergloo-system"},
+						MeshType: &v1.Mesh_Istio{
+							Istio: &v1.Istio{},
+						},
+					},
+				},
+			},
+			Upstreams: map[string]gloov1.UpstreamList{
+				"also gets ignored": {
+					upstream("default-reviews-9080", "reviews"),
+					upstream("default-reviews-v2-9080", "reviews-v2"),
+				},
+			},
+			Routingrules: map[string]v1.RoutingRuleList{
+				"": rules,
+			},
+		}
+	}
+	linkerdMesh := &core.ResourceRef{Name: "linkerd", Namespace: "supergloo-system"}
+	reviews := &core.ResourceRef{Name: "default-reviews-9080", Namespace: "gloo-system"}
+	reviewsV2 := &core.ResourceRef{Name: "default-reviews-v2-9080", Namespace: "gloo-system"}
+
+	BeforeEach(func() {
+		memory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		spClient = &memoryServiceProfileClient{profiles: make(map[string]*ServiceProfile)}
+		var err error
+		tsClient, err = splitv1alpha1.NewTrafficSplitClient(memory)
+		Expect(err).NotTo(HaveOccurred())
+		err = tsClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		s = NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			spClient,
+			splitv1alpha1.NewTrafficSplitReconciler(tsClient),
+			nil,
+		)
+	})
+
+	It("creates service profiles and traffic splits for linkerd2 rules", func() {
+		err := s.Sync(context.TODO(), snapshot(
+			&v1.RoutingRule{
+				Metadata:     core.Metadata{Name: "timeout", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{reviews},
+				RequestMatchers: []*gloov1.Matcher{{
+					PathSpecifier: &gloov1.Matcher_Prefix{Prefix: "/api"},
+					Methods:       []string{"GET"},
+				}},
+				Timeout: types.DurationProto(time.Second * 2),
+			},
+			&v1.RoutingRule{
+				Metadata:     core.Metadata{Name: "retries", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{reviews},
+				RequestMatchers: []*gloov1.Matcher{{
+					PathSpecifier: &gloov1.Matcher_Prefix{Prefix: "/api"},
+					Methods:       []string{"GET"},
+				}},
+				Retries: &v1alpha3.HTTPRetry{Attempts: 3},
+			},
+			&v1.RoutingRule{
+				Metadata:     core.Metadata{Name: "trafficshifting", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{reviews},
+				TrafficShifting: &v1.TrafficShifting{
+					Destinations: []*v1.WeightedDestination{
+						{Upstream: reviews, Weight: 90},
+						{Upstream: reviewsV2, Weight: 10},
+					},
+				},
+			},
+			&v1.RoutingRule{
+				Metadata:   core.Metadata{Name: "istio", Namespace: namespace},
+				TargetMesh: &core.ResourceRef{Name: "istio", Namespace: "supergloo-system"},
+				Timeout:    types.DurationProto(time.Second),
+			},
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		sp, err := spClient.List(namespace, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sp).To(HaveLen(1))
+		Expect(sp[0].Metadata.Name).To(Equal("reviews.default.svc.cluster.local"))
+		Expect(sp[0].Metadata.Labels).To(Equal(map[string]string{"reconciler.solo.io": "supergloo.linkerd2.routing"}))
+		Expect(sp[0].Spec.Routes).To(Equal([]*linkerdv1alpha1.RouteSpec{{
+			Name: "default.retries",
+			Condition: &linkerdv1alpha1.RequestMatch{
+				All: []*linkerdv1alpha1.RequestMatch{
+					{PathRegex: "/api.*"},
+					{Method: "GET"},
+				},
+			},
+			IsRetryable: true,
+			Timeout:     "2s",
+		}}))
+
+		ts, err := tsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ts).To(HaveLen(1))
+		Expect(ts[0].Metadata.Name).To(Equal("reviews"))
+		Expect(ts[0].Service).To(Equal("reviews"))
+		Expect(ts[0].Backends).To(Equal([]*splitv1alpha1.TrafficSplitBackend{
+			{Service: "reviews", Weight: 90},
+			{Service: "reviews-v2", Weight: 10},
+		}))
+	})
+
+	It("reports the status of each rule and skips the rules it cannot translate", func() {
+		rrClient, err := v1.NewRoutingRuleClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		s = NewMeshRoutingSyncer([]string{namespace},
+			nil,
+			spClient,
+			splitv1alpha1.NewTrafficSplitReconciler(tsClient),
+			reporter.NewReporter("supergloo", rrClient.BaseClient()),
+		)
+		for _, rule := range []*v1.RoutingRule{
+			{
+				Metadata:     core.Metadata{Name: "timeout", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{reviews},
+				Timeout:      types.DurationProto(time.Second),
+			},
+			{
+				Metadata:     core.Metadata{Name: "timeout-redefined", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{reviews, reviewsV2},
+				Timeout:      types.DurationProto(time.Second * 2),
+			},
+			{
+				Metadata:   core.Metadata{Name: "no-destinations", Namespace: namespace},
+				TargetMesh: linkerdMesh,
+				TrafficShifting: &v1.TrafficShifting{
+					Destinations: []*v1.WeightedDestination{{Upstream: reviewsV2, Weight: 100}},
+				},
+			},
+			{
+				Metadata:     core.Metadata{Name: "missing-upstream", Namespace: namespace},
+				TargetMesh:   linkerdMesh,
+				Destinations: []*core.ResourceRef{{Name: "missing", Namespace: "gloo-system"}},
+				Timeout:      types.DurationProto(time.Second),
+			},
+			{
+				Metadata:       core.Metadata{Name: "fault-injection", Namespace: namespace},
+				TargetMesh:     linkerdMesh,
+				Destinations:   []*core.ResourceRef{reviewsV2},
+				Timeout:        types.DurationProto(time.Second),
+				FaultInjection: &v1alpha3.HTTPFaultInjection{},
+				Sources:        []*core.ResourceRef{reviews},
+			},
+			{
+				Metadata:   core.Metadata{Name: "istio", Namespace: namespace},
+				TargetMesh: &core.ResourceRef{Name: "istio", Namespace: "supergloo-system"},
+				Timeout:    types.DurationProto(time.Second),
+			},
+		} {
+			_, err := rrClient.Write(rule, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		rules, err := rrClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = s.Sync(context.TODO(), snapshot(rules...))
+		Expect(err).NotTo(HaveOccurred())
+
+		status := func(name string) core.Status {
+			rule, err := rrClient.Read(namespace, name, clients.ReadOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			return rule.Status
+		}
+		Expect(status("timeout").State).To(Equal(core.Status_Accepted))
+		Expect(status("timeout-redefined").State).To(Equal(core.Status_Rejected))
+		Expect(status("timeout-redefined").Reason).To(ContainSubstring("Timeout redefined"))
+		Expect(status("no-destinations").State).To(Equal(core.Status_Rejected))
+		Expect(status("no-destinations").Reason).To(ContainSubstring("traffic shifting on linkerd2 requires at least one destination"))
+		Expect(status("missing-upstream").State).To(Equal(core.Status_Rejected))
+		Expect(status("missing-upstream").Reason).To(ContainSubstring("invalid destination"))
+		Expect(status("fault-injection").State).To(Equal(core.Status_Rejected))
+		Expect(status("fault-injection").Reason).To(ContainSubstring("sources, fault injection not supported by linkerd2"))
+		// reported by the istio routing syncer
+		Expect(status("istio").State).To(Equal(core.Status_Pending))
+
+		// the rules which are left out do not change the profiles of the others
+		sp, err := spClient.List(namespace, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sp).To(HaveLen(1))
+		Expect(sp[0].Metadata.Name).To(Equal("reviews.default.svc.cluster.local"))
+		Expect(sp[0].Spec.Routes).To(HaveLen(1))
+		Expect(sp[0].Spec.Routes[0].Timeout).To(Equal("1s"))
+		ts, err := tsClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ts).To(BeEmpty())
+	})
+
+	It("cleans up resources when rules are removed", func() {
+		err := s.Sync(context.TODO(), snapshot(
+			&v1.RoutingRule{
+				Metadata:   core.Metadata{Name: "timeout", Namespace: namespace},
+				TargetMesh: linkerdMesh,
+				Timeout:    types.DurationProto(time.Second),
+			},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		sp, err := spClient.List(namespace, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sp).To(HaveLen(2))
+
+		err = s.Sync(context.TODO(), snapshot())
+		Expect(err).NotTo(HaveOccurred())
+		sp, err = spClient.List(namespace, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sp).To(HaveLen(0))
+	})
+})
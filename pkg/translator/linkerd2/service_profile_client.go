@@ -0,0 +1,144 @@
+package linkerd2
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/utils/protoutils"
+	linkerdv1alpha1 "github.com/solo-io/supergloo/pkg/api/external/linkerd/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+var serviceProfileResource = schema.GroupVersionResource{
+	Group:    "linkerd.io",
+	Version:  "v1alpha1",
+	Resource: "serviceprofiles",
+}
+
+// ServiceProfile is a linkerd2 service profile as stored in kubernetes.
+// linkerd2 looks up profiles by the fully qualified name of their service,
+// which solo-kit resource clients reject, so profiles are read and written
+// through a dynamic kube client rather than a generated one
+type ServiceProfile struct {
+	Metadata core.Metadata
+	Spec     *linkerdv1alpha1.ServiceProfileSpec
+}
+
+type ServiceProfileClient interface {
+	List(namespace string, selector map[string]string) ([]*ServiceProfile, error)
+	// creates the profile, or updates it if it already exists
+	Write(profile *ServiceProfile) error
+	Delete(namespace, name string) error
+}
+
+type kubeServiceProfileClient struct {
+	client dynamic.Interface
+}
+
+func NewKubeServiceProfileClient(cfg *rest.Config) (ServiceProfileClient, error) {
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating dynamic kube client")
+	}
+	return &kubeServiceProfileClient{client: client}, nil
+}
+
+func (c *kubeServiceProfileClient) List(namespace string, selector map[string]string) ([]*ServiceProfile, error) {
+	list, err := c.client.Resource(serviceProfileResource).Namespace(namespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+	if err != nil {
+		// linkerd2 (and its crds) may not be installed
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "listing service profiles in %v", namespace)
+	}
+	var profiles []*ServiceProfile
+	for _, item := range list.Items {
+		profile, err := fromUnstructured(&item)
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting service profile %v.%v", item.GetNamespace(), item.GetName())
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+func (c *kubeServiceProfileClient) Write(profile *ServiceProfile) error {
+	obj, err := toUnstructured(profile)
+	if err != nil {
+		return errors.Wrapf(err, "converting service profile %v", profile.Metadata.Ref())
+	}
+	client := c.client.Resource(serviceProfileResource).Namespace(profile.Metadata.Namespace)
+	existing, err := client.Get(profile.Metadata.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "reading service profile %v", profile.Metadata.Ref())
+		}
+		if _, err := client.Create(obj, metav1.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "creating service profile %v", profile.Metadata.Ref())
+		}
+		return nil
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if _, err := client.Update(obj, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "updating service profile %v", profile.Metadata.Ref())
+	}
+	return nil
+}
+
+func (c *kubeServiceProfileClient) Delete(namespace, name string) error {
+	err := c.client.Resource(serviceProfileResource).Namespace(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting service profile %v.%v", namespace, name)
+	}
+	return nil
+}
+
+func toUnstructured(profile *ServiceProfile) (*unstructured.Unstructured, error) {
+	spec := map[string]interface{}{}
+	if profile.Spec != nil {
+		var err error
+		spec, err = protoutils.MarshalMap(profile.Spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": spec,
+	}}
+	obj.SetAPIVersion(serviceProfileResource.GroupVersion().String())
+	obj.SetKind("ServiceProfile")
+	obj.SetName(profile.Metadata.Name)
+	obj.SetNamespace(profile.Metadata.Namespace)
+	obj.SetLabels(profile.Metadata.Labels)
+	obj.SetAnnotations(profile.Metadata.Annotations)
+	return obj, nil
+}
+
+func fromUnstructured(obj *unstructured.Unstructured) (*ServiceProfile, error) {
+	var spec linkerdv1alpha1.ServiceProfileSpec
+	specMap, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+	if err := protoutils.UnmarshalMap(specMap, &spec); err != nil {
+		return nil, err
+	}
+	return &ServiceProfile{
+		Metadata: core.Metadata{
+			Name:            obj.GetName(),
+			Namespace:       obj.GetNamespace(),
+			ResourceVersion: obj.GetResourceVersion(),
+			Labels:          obj.GetLabels(),
+			Annotations:     obj.GetAnnotations(),
+		},
+		Spec: &spec,
+	}, nil
+}
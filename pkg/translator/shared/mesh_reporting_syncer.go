@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"go.uber.org/multierr"
+)
+
+// MeshSyncError is returned by translator syncers for a mesh they failed to sync.
+// syncers keep going after a mesh fails so one broken mesh cannot block the others;
+// the MeshReportingSyncer reports these errors on the status of the failed mesh
+type MeshSyncError struct {
+	Mesh core.ResourceRef
+	Err  error
+}
+
+func NewMeshSyncError(mesh *v1.Mesh, err error) *MeshSyncError {
+	return &MeshSyncError{Mesh: mesh.Metadata.Ref(), Err: err}
+}
+
+func (e *MeshSyncError) Error() string {
+	return fmt.Sprintf("syncing mesh %v failed: %v", e.Mesh, e.Err)
+}
+
+// returns the mesh errors contained in an error returned by one or more syncers
+func MeshSyncErrors(err error) []*MeshSyncError {
+	switch err := err.(type) {
+	case nil:
+		return nil
+	case *MeshSyncError:
+		return []*MeshSyncError{err}
+	case *multierror.Error:
+		var meshErrs []*MeshSyncError
+		for _, e := range err.Errors {
+			meshErrs = append(meshErrs, MeshSyncErrors(e)...)
+		}
+		return meshErrs
+	}
+	errs := multierr.Errors(err)
+	if len(errs) < 2 {
+		return nil
+	}
+	var meshErrs []*MeshSyncError
+	for _, e := range errs {
+		meshErrs = append(meshErrs, MeshSyncErrors(e)...)
+	}
+	return meshErrs
+}
+
+// MeshReportingSyncer runs the translator syncers and writes the status of every mesh in the snapshot:
+// a mesh is rejected with the errors of each syncer which failed to sync it, and accepted otherwise.
+// the errors of the rules of mesh policies are reported on their target mesh, the mesh policies themselves
+// are only rejected if their target mesh does not exist or if their rules have destinations outside of their namespace
+type MeshReportingSyncer struct {
+	Syncers  v1.TranslatorSyncers
+	Reporter reporter.Reporter
+}
+
+func (s *MeshReportingSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	err := s.Syncers.Sync(ctx, snap)
+
+	meshes := snap.Meshes.List()
+	resourceErrs := make(reporter.ResourceErrors)
+	for _, mesh := range meshes {
+		resourceErrs.Accept(mesh)
+	}
+	for _, meshPolicy := range snap.Meshpolicies.List() {
+		if meshPolicy.TargetMesh == nil {
+			resourceErrs.AddError(meshPolicy, errors.Errorf("target mesh is required"))
+			continue
+		}
+		if _, findErr := meshes.Find(meshPolicy.TargetMesh.Strings()); findErr != nil {
+			resourceErrs.AddError(meshPolicy, errors.Errorf("target mesh %v not found", meshPolicy.TargetMesh.Key()))
+			continue
+		}
+		if ruleErr := MeshPolicyRuleErrors(meshPolicy, snap.Upstreams.List()); ruleErr != nil {
+			// the other rules are still applied
+			resourceErrs.AddError(meshPolicy, ruleErr)
+			continue
+		}
+		resourceErrs.Accept(meshPolicy)
+	}
+	for _, meshErr := range MeshSyncErrors(err) {
+		mesh, findErr := meshes.Find(meshErr.Mesh.Strings())
+		if findErr != nil {
+			continue
+		}
+		resourceErrs.AddError(mesh, meshErr.Err)
+	}
+	if reportErr := s.Reporter.WriteReports(ctx, resourceErrs, nil); reportErr != nil {
+		err = multierror.Append(err, errors.Wrapf(reportErr, "writing mesh reports"))
+	}
+	return err
+}
@@ -0,0 +1,172 @@
+package shared_test
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reporter"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/shared"
+	"go.uber.org/multierr"
+)
+
+type syncerFunc func(ctx context.Context, snap *v1.TranslatorSnapshot) error
+
+func (f syncerFunc) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+	return f(ctx, snap)
+}
+
+var _ = Describe("MeshReportingSyncer", func() {
+	var (
+		meshClient v1.MeshClient
+		meshes     v1.MeshList
+	)
+	BeforeEach(func() {
+		var err error
+		meshClient, err = v1.NewMeshClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		err = meshClient.Register()
+		Expect(err).NotTo(HaveOccurred())
+		meshes = nil
+		for _, name := range []string{"broken", "healthy"} {
+			mesh, err := meshClient.Write(&v1.Mesh{
+				Metadata: core.Metadata{Name: name, Namespace: "supergloo-system"},
+				MeshType: &v1.Mesh_Istio{Istio: &v1.Istio{}},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			meshes = append(meshes, mesh)
+		}
+	})
+
+	It("reports the errors of each syncer on the mesh which failed, and accepts the others", func() {
+		var healthySynced bool
+		s := &MeshReportingSyncer{
+			Reporter: reporter.NewReporter("supergloo", meshClient.BaseClient()),
+			Syncers: v1.TranslatorSyncers{
+				syncerFunc(func(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+					var errs error
+					for _, mesh := range snap.Meshes.List() {
+						if mesh.Metadata.Name == "broken" {
+							errs = multierr.Append(errs, NewMeshSyncError(mesh, errors.New("routing failed")))
+							continue
+						}
+						healthySynced = true
+					}
+					return errs
+				}),
+				syncerFunc(func(ctx context.Context, snap *v1.TranslatorSnapshot) error {
+					var multiErr *multierror.Error
+					for _, mesh := range snap.Meshes.List() {
+						if mesh.Metadata.Name == "broken" {
+							multiErr = multierror.Append(multiErr, NewMeshSyncError(mesh, errors.New("policy failed")))
+						}
+					}
+					return multiErr.ErrorOrNil()
+				}),
+			},
+		}
+		err := s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes: map[string]v1.MeshList{"": meshes},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(healthySynced).To(BeTrue())
+
+		broken, err := meshClient.Read("supergloo-system", "broken", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(broken.Status.State).To(Equal(core.Status_Rejected))
+		Expect(broken.Status.Reason).To(ContainSubstring("routing failed"))
+		Expect(broken.Status.Reason).To(ContainSubstring("policy failed"))
+
+		healthy, err := meshClient.Read("supergloo-system", "healthy", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(healthy.Status.State).To(Equal(core.Status_Accepted))
+	})
+
+	It("rejects the mesh policies whose target mesh does not exist", func() {
+		meshPolicyClient, err := v1.NewMeshPolicyClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var meshPolicies v1.MeshPolicyList
+		for name, target := range map[string]string{"valid": "healthy", "invalid": "missing"} {
+			meshPolicy, err := meshPolicyClient.Write(&v1.MeshPolicy{
+				Metadata:   core.Metadata{Name: name, Namespace: "default"},
+				TargetMesh: &core.ResourceRef{Name: target, Namespace: "supergloo-system"},
+			}, clients.WriteOpts{})
+			Expect(err).NotTo(HaveOccurred())
+			meshPolicies = append(meshPolicies, meshPolicy)
+		}
+		s := &MeshReportingSyncer{
+			Reporter: reporter.NewReporter("supergloo", meshClient.BaseClient(), meshPolicyClient.BaseClient()),
+		}
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes:       map[string]v1.MeshList{"": meshes},
+			Meshpolicies: meshPolicies.ByNamespace(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		valid, err := meshPolicyClient.Read("default", "valid", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valid.Status.State).To(Equal(core.Status_Accepted))
+		invalid, err := meshPolicyClient.Read("default", "invalid", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(invalid.Status.State).To(Equal(core.Status_Rejected))
+		Expect(invalid.Status.Reason).To(ContainSubstring("target mesh supergloo-system.missing not found"))
+	})
+
+	It("rejects the mesh policies with rules to the services of other namespaces", func() {
+		meshPolicyClient, err := v1.NewMeshPolicyClient(&factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		ref := func(name string) *core.ResourceRef {
+			return &core.ResourceRef{Name: name, Namespace: "gloo-system"}
+		}
+		meshPolicy, err := meshPolicyClient.Write(&v1.MeshPolicy{
+			Metadata:   core.Metadata{Name: "team-a", Namespace: "team-a"},
+			TargetMesh: &core.ResourceRef{Name: "healthy", Namespace: "supergloo-system"},
+			Rules:      []*v1.Rule{{Source: ref("productpage"), Destination: ref("reviews")}},
+			DenyRules:  []*v1.Rule{{Source: ref("productpage"), Destination: ref("ratings")}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		upstream := func(name, serviceNamespace string) *gloov1.Upstream {
+			return &gloov1.Upstream{
+				Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+				UpstreamSpec: &gloov1.UpstreamSpec{
+					UpstreamType: &gloov1.UpstreamSpec_Kube{
+						Kube: &kubernetes.UpstreamSpec{ServiceName: name, ServiceNamespace: serviceNamespace},
+					},
+				},
+			}
+		}
+		s := &MeshReportingSyncer{
+			Reporter: reporter.NewReporter("supergloo", meshClient.BaseClient(), meshPolicyClient.BaseClient()),
+		}
+		err = s.Sync(context.TODO(), &v1.TranslatorSnapshot{
+			Meshes:       map[string]v1.MeshList{"": meshes},
+			Meshpolicies: v1.MeshPolicyList{meshPolicy}.ByNamespace(),
+			Upstreams: map[string]gloov1.UpstreamList{
+				"gloo-system": {upstream("productpage", "team-a"), upstream("reviews", "team-a"), upstream("ratings", "team-b")},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		meshPolicy, err = meshPolicyClient.Read("team-a", "team-a", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshPolicy.Status.State).To(Equal(core.Status_Rejected))
+		Expect(meshPolicy.Status.Reason).To(ContainSubstring("policy rule from gloo-system.productpage to gloo-system.ratings cannot be applied: " +
+			"the destination is in namespace team-b"))
+		Expect(meshPolicy.Status.Reason).NotTo(ContainSubstring("gloo-system.reviews"))
+	})
+})
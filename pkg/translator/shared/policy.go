@@ -0,0 +1,183 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	"github.com/solo-io/supergloo/pkg/translator/kube"
+)
+
+// MergedPolicy returns the policy of the mesh along with the rules of the mesh policies targeting it,
+// or nil if there are none. the mesh policies are merged in the order of their namespaces and names.
+// a mesh without a policy of its own enforces the merged policy only for the namespaces of the mesh policies,
+// so that a team creating a mesh policy does not deny the requests to the services of the other teams.
+// the rules of a mesh policy whose destinations are not in its namespace are left out, see MeshPolicyRuleErrors
+func MergedPolicy(mesh *v1.Mesh, meshPolicies v1.MeshPolicyList, upstreams gloov1.UpstreamList) *v1.Policy {
+	var merged *v1.Policy
+	if mesh.Policy != nil {
+		// the snapshot is not modified
+		merged = proto.Clone(mesh.Policy).(*v1.Policy)
+	}
+	ownPolicy := merged != nil
+	ref := mesh.Metadata.Ref()
+	for _, meshPolicy := range meshPolicies.Sort() {
+		if meshPolicy.TargetMesh == nil || *meshPolicy.TargetMesh != ref {
+			continue
+		}
+		if merged == nil {
+			merged = &v1.Policy{}
+		}
+		if !ownPolicy && !containsString(merged.Namespaces, meshPolicy.Metadata.Namespace) {
+			merged.Namespaces = append(merged.Namespaces, meshPolicy.Metadata.Namespace)
+		}
+		for _, rule := range meshPolicy.Rules {
+			if meshPolicyRuleError(meshPolicy, rule, upstreams) == nil {
+				merged.Rules = append(merged.Rules, rule)
+			}
+		}
+		for _, rule := range meshPolicy.DenyRules {
+			if meshPolicyRuleError(meshPolicy, rule, upstreams) == nil {
+				merged.DenyRules = append(merged.DenyRules, rule)
+			}
+		}
+	}
+	return merged
+}
+
+// MeshPolicyRuleErrors returns the errors of the rules of the mesh policy whose destinations are not in its
+// namespace: a mesh policy only allows or denies the requests to the services of its own namespace
+func MeshPolicyRuleErrors(meshPolicy *v1.MeshPolicy, upstreams gloov1.UpstreamList) error {
+	var errs *multierror.Error
+	for _, rule := range append(append([]*v1.Rule{}, meshPolicy.Rules...), meshPolicy.DenyRules...) {
+		if err := meshPolicyRuleError(meshPolicy, rule, upstreams); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func meshPolicyRuleError(meshPolicy *v1.MeshPolicy, rule *v1.Rule, upstreams gloov1.UpstreamList) error {
+	if rule.Source == nil || rule.Destination == nil {
+		return errors.Errorf("policy rule cannot be applied: its source and destination are required")
+	}
+	namespace, ok := DestinationNamespace(upstreams, *rule.Destination)
+	if !ok {
+		return errors.Errorf("policy rule from %v to %v cannot be applied: destination upstream not found",
+			rule.Source.Key(), rule.Destination.Key())
+	}
+	if namespace != meshPolicy.Metadata.Namespace {
+		return errors.Errorf("policy rule from %v to %v cannot be applied: the destination is in namespace %v, "+
+			"the mesh policy only applies to the services in namespace %v",
+			rule.Source.Key(), rule.Destination.Key(), namespace, meshPolicy.Metadata.Namespace)
+	}
+	return nil
+}
+
+// DestinationNamespace returns the namespace the policies are enforced in for the upstream: the namespace of
+// its service for the kubernetes upstreams, its own namespace otherwise. false is returned if it does not exist
+func DestinationNamespace(upstreams gloov1.UpstreamList, ref core.ResourceRef) (string, bool) {
+	upstream, err := upstreams.Find(ref.Namespace, ref.Name)
+	if err != nil {
+		return "", false
+	}
+	if kubeUpstream := upstream.UpstreamSpec.GetKube(); kubeUpstream != nil {
+		return kubeUpstream.ServiceNamespace, true
+	}
+	return upstream.Metadata.Namespace, true
+}
+
+// RuleRestrictions returns the restrictions of the rule on the requests from its source to its destination
+func RuleRestrictions(rule *v1.Rule) []string {
+	var restrictions []string
+	if len(rule.Methods) > 0 {
+		restrictions = append(restrictions, "methods")
+	}
+	if len(rule.PathPrefixes) > 0 {
+		restrictions = append(restrictions, "path prefixes")
+	}
+	if len(rule.Ports) > 0 {
+		restrictions = append(restrictions, "ports")
+	}
+	return restrictions
+}
+
+// UnsupportedRestrictionsError is returned for the policy rules which restrict the requests
+// in ways the mesh cannot express. the rule is not applied, so the requests it describes are not allowed
+func UnsupportedRestrictionsError(rule *v1.Rule, meshType string) error {
+	return errors.Errorf("policy rule from %v to %v cannot be applied: %v are not supported by %v",
+		rule.GetSource().Key(), rule.GetDestination().Key(), strings.Join(RuleRestrictions(rule), ", "), meshType)
+}
+
+// UnsupportedPolicyError is returned for the policies the mesh cannot enforce as intended. rather than
+// denying requests the policy is meant to allow, the mesh does not enforce the policy at all
+func UnsupportedPolicyError(feature, meshType string) error {
+	return errors.Errorf("policy is not enforced: %v are not supported by %v", feature, meshType)
+}
+
+// PolicyEnforced returns whether the policy is enforced for the destination services in the namespace
+func PolicyEnforced(p *v1.Policy, namespace string) bool {
+	if p.Mode == v1.Policy_OFF {
+		return false
+	}
+	if len(p.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range p.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+type rulePath struct {
+	source, destination core.ResourceRef
+}
+
+// AllowedRules returns the rules of the policy which are not overridden by its deny rules, for the meshes which
+// deny all the requests the rules do not allow, and so deny requests by leaving out the rules which allow them.
+// as these meshes cannot deny part of the requests a rule allows, a deny rule which restricts the requests
+// overrides all the rules from its source to its destination, which is returned as an error.
+// the deny rules for the kube services the policy is not enforced for are returned as an error as well
+func AllowedRules(p *v1.Policy, upstreams gloov1.UpstreamList, meshType string) ([]*v1.Rule, error) {
+	var errs *multierror.Error
+	denied := map[rulePath]*v1.Rule{}
+	for _, rule := range p.DenyRules {
+		if rule.Source == nil || rule.Destination == nil {
+			continue
+		}
+		denied[rulePath{*rule.Source, *rule.Destination}] = rule
+		if p.Mode == v1.Policy_OFF {
+			continue
+		}
+		if dest := kube.KubeUpstream(upstreams, *rule.Destination); dest != nil && !PolicyEnforced(p, dest.ServiceNamespace) {
+			errs = multierror.Append(errs, errors.Errorf("deny rule from %v to %v cannot be applied: "+
+				"the policy is not enforced for the services in namespace %v", rule.Source.Key(), rule.Destination.Key(), dest.ServiceNamespace))
+		}
+	}
+
+	var allowed []*v1.Rule
+	for _, rule := range p.Rules {
+		if rule.Source == nil || rule.Destination == nil {
+			allowed = append(allowed, rule)
+			continue
+		}
+		deny, ok := denied[rulePath{*rule.Source, *rule.Destination}]
+		if !ok {
+			allowed = append(allowed, rule)
+			continue
+		}
+		if restrictions := RuleRestrictions(deny); len(restrictions) > 0 {
+			errs = multierror.Append(errs, errors.Errorf("deny rule from %v to %v denies all the requests from its source "+
+				"to its destination: %v are not supported by %v", deny.Source.Key(), deny.Destination.Key(), strings.Join(restrictions, ", "), meshType))
+			// reported once, the other rules of the same path are left out silently
+			denied[rulePath{*rule.Source, *rule.Destination}] = &v1.Rule{Source: deny.Source, Destination: deny.Destination}
+		}
+	}
+	return allowed, errs.ErrorOrNil()
+}
@@ -0,0 +1,118 @@
+package shared_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/external/gloo/v1/plugins/kubernetes"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+	. "github.com/solo-io/supergloo/pkg/translator/shared"
+)
+
+var _ = Describe("MergedPolicy", func() {
+	upstream := func(name, serviceNamespace string) *gloov1.Upstream {
+		return &gloov1.Upstream{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamSpec: &gloov1.UpstreamSpec{
+				UpstreamType: &gloov1.UpstreamSpec_Kube{
+					Kube: &kubernetes.UpstreamSpec{ServiceName: name, ServiceNamespace: serviceNamespace},
+				},
+			},
+		}
+	}
+	upstreams := gloov1.UpstreamList{
+		upstream("productpage", "team-a"),
+		upstream("reviews", "team-a"),
+		upstream("ratings", "team-b"),
+	}
+	rule := func(source, destination string) *v1.Rule {
+		return &v1.Rule{
+			Source:      &core.ResourceRef{Name: source, Namespace: "gloo-system"},
+			Destination: &core.ResourceRef{Name: destination, Namespace: "gloo-system"},
+		}
+	}
+	meshPolicy := func(namespace, mesh string, rules ...*v1.Rule) *v1.MeshPolicy {
+		return &v1.MeshPolicy{
+			Metadata:   core.Metadata{Name: "policy", Namespace: namespace},
+			TargetMesh: &core.ResourceRef{Name: mesh, Namespace: "supergloo-system"},
+			Rules:      rules,
+		}
+	}
+	mesh := &v1.Mesh{Metadata: core.Metadata{Name: "istio", Namespace: "supergloo-system"}}
+
+	It("merges the rules of the mesh policies targeting the mesh with its policy", func() {
+		mesh.Policy = &v1.Policy{
+			Mode:      v1.Policy_PERMISSIVE,
+			Rules:     []*v1.Rule{rule("productpage", "reviews")},
+			DenyRules: []*v1.Rule{rule("productpage", "ratings")},
+		}
+		meshPolicies := v1.MeshPolicyList{
+			meshPolicy("team-b", "istio", rule("reviews", "ratings")),
+			meshPolicy("other", "linkerd", rule("other", "reviews")),
+			meshPolicy("team-a", "istio", rule("ratings", "reviews")),
+		}
+
+		merged := MergedPolicy(mesh, meshPolicies, upstreams)
+		Expect(merged.Mode).To(Equal(v1.Policy_PERMISSIVE))
+		// the mesh decides where its policy is enforced
+		Expect(merged.Namespaces).To(BeEmpty())
+		Expect(merged.Rules).To(Equal([]*v1.Rule{rule("productpage", "reviews"), rule("ratings", "reviews"), rule("reviews", "ratings")}))
+		Expect(merged.DenyRules).To(Equal([]*v1.Rule{rule("productpage", "ratings")}))
+		// the mesh is left as is
+		Expect(mesh.Policy.Rules).To(HaveLen(1))
+	})
+
+	It("returns a policy only if the mesh has one or is targeted by a mesh policy", func() {
+		mesh.Policy = nil
+		Expect(MergedPolicy(mesh, v1.MeshPolicyList{meshPolicy("other", "linkerd", rule("other", "reviews"))}, upstreams)).To(BeNil())
+
+		merged := MergedPolicy(mesh, v1.MeshPolicyList{meshPolicy("team-a", "istio", rule("productpage", "reviews"))}, upstreams)
+		Expect(merged.Mode).To(Equal(v1.Policy_ENFORCED))
+		Expect(merged.Rules).To(Equal([]*v1.Rule{rule("productpage", "reviews")}))
+	})
+
+	It("enforces the mesh policies of a mesh without a policy only for their namespaces", func() {
+		mesh.Policy = nil
+		merged := MergedPolicy(mesh, v1.MeshPolicyList{
+			meshPolicy("team-b", "istio", rule("reviews", "ratings")),
+			meshPolicy("team-a", "istio"),
+			meshPolicy("other", "linkerd", rule("other", "reviews")),
+		}, upstreams)
+		Expect(merged.Mode).To(Equal(v1.Policy_ENFORCED))
+		Expect(merged.Namespaces).To(Equal([]string{"team-a", "team-b"}))
+		Expect(PolicyEnforced(merged, "team-a")).To(BeTrue())
+		Expect(PolicyEnforced(merged, "team-c")).To(BeFalse())
+	})
+
+	It("leaves out the rules of the mesh policies whose destinations are not in their namespace", func() {
+		mesh.Policy = nil
+		teamA := meshPolicy("team-a", "istio",
+			rule("productpage", "reviews"),
+			// the destinations of team-b
+			rule("productpage", "ratings"),
+		)
+		teamA.DenyRules = []*v1.Rule{rule("reviews", "ratings"), rule("ratings", "productpage")}
+
+		merged := MergedPolicy(mesh, v1.MeshPolicyList{teamA}, upstreams)
+		Expect(merged.Rules).To(Equal([]*v1.Rule{rule("productpage", "reviews")}))
+		Expect(merged.DenyRules).To(Equal([]*v1.Rule{rule("ratings", "productpage")}))
+
+		err := MeshPolicyRuleErrors(teamA, upstreams)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("policy rule from gloo-system.productpage to gloo-system.ratings cannot be applied: " +
+			"the destination is in namespace team-b, the mesh policy only applies to the services in namespace team-a"))
+		Expect(err.Error()).To(ContainSubstring("policy rule from gloo-system.reviews to gloo-system.ratings cannot be applied"))
+		Expect(err.Error()).NotTo(ContainSubstring("to gloo-system.reviews cannot"))
+	})
+
+	It("leaves out the rules of the mesh policies whose destinations do not exist", func() {
+		teamA := meshPolicy("team-a", "istio", rule("productpage", "details"))
+		Expect(MergedPolicy(mesh, v1.MeshPolicyList{teamA}, upstreams).Rules).To(BeEmpty())
+		err := MeshPolicyRuleErrors(teamA, upstreams)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("policy rule from gloo-system.productpage to gloo-system.details cannot be applied: " +
+			"destination upstream not found"))
+		Expect(MeshPolicyRuleErrors(meshPolicy("team-a", "istio", rule("productpage", "reviews")), upstreams)).NotTo(HaveOccurred())
+	})
+})
@@ -45,7 +45,7 @@ func (s *PrometheusSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 	for _, mesh := range snap.Meshes.List() {
 		logger.Infof("syncing mesh %v", mesh.Metadata.Ref())
 		if err := s.syncMesh(ctx, mesh); err != nil {
-			errs = multierr.Append(errs, errors.Wrapf(err, "syncing mesh %v failed", mesh.Metadata.Ref()))
+			errs = multierr.Append(errs, NewMeshSyncError(mesh, err))
 			continue
 		}
 	}
@@ -0,0 +1,119 @@
+package shared
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	gloov1 "github.com/solo-io/supergloo/pkg/api/external/gloo/v1"
+	"github.com/solo-io/supergloo/pkg/api/v1"
+)
+
+// returns the rules which target the given mesh
+func RulesForMesh(rules v1.RoutingRuleList, mesh *v1.Mesh) v1.RoutingRuleList {
+	var meshRules v1.RoutingRuleList
+	for _, rule := range rules {
+		if rule.TargetMesh != nil && rule.TargetMesh.Namespace == mesh.Metadata.Namespace &&
+			rule.TargetMesh.Name == mesh.Metadata.Name {
+			meshRules = append(meshRules, rule)
+		}
+	}
+	return meshRules
+}
+
+// ExpandUpstreamSelectors returns a copy of the rule whose sources and destinations also list the upstreams
+// matched by its source and destination selectors, or the rule itself if it has no selectors.
+// the rule must not be written back, its status belongs to the original rule
+func ExpandUpstreamSelectors(rule *v1.RoutingRule, upstreams gloov1.UpstreamList) (*v1.RoutingRule, error) {
+	if rule.SourceSelector == nil && rule.DestinationSelector == nil {
+		return rule, nil
+	}
+	expanded := *rule
+	if rule.SourceSelector != nil {
+		selected, err := SelectUpstreams(rule.SourceSelector, upstreams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid source selector")
+		}
+		expanded.Sources = appendUniqueRefs(rule.Sources, selected)
+		expanded.SourceSelector = nil
+	}
+	if rule.DestinationSelector != nil {
+		selected, err := SelectUpstreams(rule.DestinationSelector, upstreams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid destination selector")
+		}
+		expanded.Destinations = appendUniqueRefs(rule.Destinations, selected)
+		expanded.DestinationSelector = nil
+	}
+	return &expanded, nil
+}
+
+// SelectUpstreams returns the kubernetes upstreams matching every field set on the selector.
+// it is an error for a selector to match no upstream, as an empty list of sources or destinations
+// would apply the rule to all of them
+func SelectUpstreams(selector *v1.UpstreamSelector, upstreams gloov1.UpstreamList) ([]*core.ResourceRef, error) {
+	if len(selector.Labels) == 0 && len(selector.Namespaces) == 0 && len(selector.Services) == 0 {
+		return nil, errors.Errorf("one of labels, namespaces or services must be set")
+	}
+	var selected []*core.ResourceRef
+	for _, us := range upstreams {
+		kube, ok := us.GetUpstreamSpec().GetUpstreamType().(*gloov1.UpstreamSpec_Kube)
+		if !ok || kube.Kube == nil {
+			continue
+		}
+		if !containsLabels(kube.Kube.Selector, selector.Labels) {
+			continue
+		}
+		if len(selector.Namespaces) > 0 && !containsString(selector.Namespaces, kube.Kube.ServiceNamespace) {
+			continue
+		}
+		if len(selector.Services) > 0 && !containsService(selector.Services, kube.Kube.ServiceName, kube.Kube.ServiceNamespace) {
+			continue
+		}
+		ref := us.Metadata.Ref()
+		selected = append(selected, &ref)
+	}
+	if len(selected) == 0 {
+		return nil, errors.Errorf("no upstreams match %v", selector)
+	}
+	return selected, nil
+}
+
+func containsLabels(labels, subset map[string]string) bool {
+	for k, v := range subset {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsService(services []*core.ResourceRef, name, namespace string) bool {
+	for _, svc := range services {
+		if svc.Name == name && svc.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUniqueRefs(refs []*core.ResourceRef, toAdd []*core.ResourceRef) []*core.ResourceRef {
+	result := append([]*core.ResourceRef{}, refs...)
+addUniqueRefs:
+	for _, ref := range toAdd {
+		for _, added := range result {
+			if added.Equal(ref) {
+				continue addUniqueRefs
+			}
+		}
+		result = append(result, ref)
+	}
+	return result
+}